@@ -1,10 +1,16 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
+
+	appsession "main/internal/application/session"
+	"main/internal/enum"
+	"main/internal/shared"
 
 	"go.uber.org/zap"
 )
@@ -18,7 +24,24 @@ type Config struct {
 	IsDev                     bool
 	FrontendDir               string
 	MagickConcurrency         int64
+	ResizeConcurrency         int64
+	PreviewFormats            []shared.ImageFormat
 	EnableDirectoryStatsCache bool
+	PrewarmCount              int
+	PrewarmSizes              []appsession.PreviewSize
+	PrewarmIdleTimeout        time.Duration
+	CacheDir                  string
+	CacheMaxSize              int64
+	CacheContentKey           bool
+	ContentIdentity           bool
+	SourceFormats             []shared.SourceFormat
+	AnimatedPreview           shared.AnimatedPreview
+	FFprobePath               string
+	FFmpegPath                string
+	StripMetadata             bool
+	EnableIndex               bool
+	IndexPath                 string
+	WatchQuietWindow          time.Duration
 }
 
 func loadConfig(logger *zap.Logger, version string) (*Config, error) {
@@ -77,6 +100,31 @@ func loadConfig(logger *zap.Logger, version string) (*Config, error) {
 		}
 	}
 
+	resizeConcurrency := int64(4)
+	if v := os.Getenv("IMAGE_FUNNEL_RESIZE_CONCURRENCY"); v != "" {
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			resizeConcurrency = i
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_RESIZE_CONCURRENCY, use default", zap.String("value", v))
+		}
+	}
+
+	previewFormats := []shared.ImageFormat{shared.ImageFormatAVIF, shared.ImageFormatWebP, shared.ImageFormatJPEG}
+	if v := os.Getenv("IMAGE_FUNNEL_PREVIEW_FORMATS"); v != "" {
+		var formats []shared.ImageFormat
+		for s := range strings.SplitSeq(v, ",") {
+			format, err := enum.Parse[shared.ImageFormatMeta](strings.ToUpper(strings.TrimSpace(s)))
+			if err != nil || format == shared.ImageFormatPNG {
+				logger.Warn("invalid IMAGE_FUNNEL_PREVIEW_FORMATS item, ignored", zap.String("value", s))
+				continue
+			}
+			formats = append(formats, format)
+		}
+		if len(formats) > 0 {
+			previewFormats = formats
+		}
+	}
+
 	enableDirectoryStatsCache := true
 	if v := os.Getenv("IMAGE_FUNNEL_ENABLE_DIRECTORY_STATS_CACHE"); v != "" {
 		if b, err := strconv.ParseBool(v); err == nil {
@@ -86,6 +134,139 @@ func loadConfig(logger *zap.Logger, version string) (*Config, error) {
 		}
 	}
 
+	prewarmCount := 5
+	if v := os.Getenv("IMAGE_FUNNEL_PREWARM_COUNT"); v != "" {
+		if i, err := strconv.Atoi(v); err == nil && i >= 0 {
+			prewarmCount = i
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_PREWARM_COUNT, use default", zap.String("value", v))
+		}
+	}
+
+	prewarmSizes := []appsession.PreviewSize{{Width: 1024, Quality: 85}}
+	if v := os.Getenv("IMAGE_FUNNEL_PREWARM_SIZES"); v != "" {
+		var sizes []appsession.PreviewSize
+		for s := range strings.SplitSeq(v, ",") {
+			size, err := parsePreviewSize(strings.TrimSpace(s))
+			if err != nil {
+				logger.Warn("invalid IMAGE_FUNNEL_PREWARM_SIZES item, ignored", zap.String("value", s))
+				continue
+			}
+			sizes = append(sizes, size)
+		}
+		if len(sizes) > 0 {
+			prewarmSizes = sizes
+		}
+	}
+
+	prewarmIdleTimeout := 5 * time.Minute
+	if v := os.Getenv("IMAGE_FUNNEL_PREWARM_IDLE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			prewarmIdleTimeout = d
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_PREWARM_IDLE_TIMEOUT, use default", zap.String("value", v))
+		}
+	}
+
+	watchQuietWindow := 500 * time.Millisecond
+	if v := os.Getenv("IMAGE_FUNNEL_WATCH_QUIET_WINDOW"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			watchQuietWindow = d
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_WATCH_QUIET_WINDOW, use default", zap.String("value", v))
+		}
+	}
+
+	cacheDir := os.Getenv("IMAGE_FUNNEL_CACHE_DIR")
+	if cacheDir == "" {
+		cacheDir = filepath.Join(os.TempDir(), "image-funnel-cache")
+	}
+
+	cacheMaxSizeMB := int64(2048)
+	if v := os.Getenv("IMAGE_FUNNEL_CACHE_MAX_SIZE_MB"); v != "" {
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil && i >= 0 {
+			cacheMaxSizeMB = i
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_CACHE_MAX_SIZE_MB, use default", zap.String("value", v))
+		}
+	}
+
+	cacheContentKey := false
+	if v := os.Getenv("IMAGE_FUNNEL_CACHE_CONTENT_KEY"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cacheContentKey = b
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_CACHE_CONTENT_KEY, use default", zap.String("value", v))
+		}
+	}
+
+	contentIdentity := false
+	if v := os.Getenv("IMAGE_FUNNEL_CONTENT_IDENTITY"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			contentIdentity = b
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_CONTENT_IDENTITY, use default", zap.String("value", v))
+		}
+	}
+
+	sourceFormats := shared.DefaultSourceFormats()
+	if v := os.Getenv("IMAGE_FUNNEL_SOURCE_FORMATS"); v != "" {
+		var formats []shared.SourceFormat
+		for s := range strings.SplitSeq(v, ",") {
+			format, err := enum.Parse[shared.SourceFormatMeta](strings.ToUpper(strings.TrimSpace(s)))
+			if err != nil {
+				logger.Warn("invalid IMAGE_FUNNEL_SOURCE_FORMATS item, ignored", zap.String("value", s))
+				continue
+			}
+			formats = append(formats, format)
+		}
+		if len(formats) > 0 {
+			sourceFormats = formats
+		}
+	}
+
+	animatedPreview := shared.AnimatedPreviewFirstFrame
+	if v := os.Getenv("IMAGE_FUNNEL_ANIMATED_PREVIEW"); v != "" {
+		if p, err := enum.Parse[shared.AnimatedPreviewMeta](strings.ToUpper(strings.TrimSpace(v))); err == nil {
+			animatedPreview = p
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_ANIMATED_PREVIEW, use default", zap.String("value", v))
+		}
+	}
+
+	ffprobePath := os.Getenv("IMAGE_FUNNEL_FFPROBE")
+	if ffprobePath == "" {
+		ffprobePath = "ffprobe"
+	}
+
+	ffmpegPath := os.Getenv("IMAGE_FUNNEL_FFMPEG")
+	if ffmpegPath == "" {
+		ffmpegPath = "ffmpeg"
+	}
+
+	stripMetadata := false
+	if v := os.Getenv("IMAGE_FUNNEL_STRIP_METADATA"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			stripMetadata = b
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_STRIP_METADATA, use default", zap.String("value", v))
+		}
+	}
+
+	enableIndex := true
+	if v := os.Getenv("IMAGE_FUNNEL_ENABLE_INDEX"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			enableIndex = b
+		} else {
+			logger.Warn("invalid IMAGE_FUNNEL_ENABLE_INDEX, use default", zap.String("value", v))
+		}
+	}
+
+	indexPath := os.Getenv("IMAGE_FUNNEL_INDEX_PATH")
+	if indexPath == "" {
+		indexPath = filepath.Join(os.TempDir(), "image-funnel-index.db")
+	}
+
 	return &Config{
 		Port:                      port,
 		RootDir:                   rootDir,
@@ -95,6 +276,40 @@ func loadConfig(logger *zap.Logger, version string) (*Config, error) {
 		IsDev:                     isDev,
 		FrontendDir:               frontendDir,
 		MagickConcurrency:         magickConcurrency,
+		ResizeConcurrency:         resizeConcurrency,
+		PreviewFormats:            previewFormats,
 		EnableDirectoryStatsCache: enableDirectoryStatsCache,
+		PrewarmCount:              prewarmCount,
+		PrewarmSizes:              prewarmSizes,
+		PrewarmIdleTimeout:        prewarmIdleTimeout,
+		CacheDir:                  cacheDir,
+		CacheMaxSize:              cacheMaxSizeMB << 20,
+		CacheContentKey:           cacheContentKey,
+		ContentIdentity:           contentIdentity,
+		SourceFormats:             sourceFormats,
+		AnimatedPreview:           animatedPreview,
+		FFprobePath:               ffprobePath,
+		FFmpegPath:                ffmpegPath,
+		StripMetadata:             stripMetadata,
+		EnableIndex:               enableIndex,
+		IndexPath:                 indexPath,
+		WatchQuietWindow:          watchQuietWindow,
 	}, nil
 }
+
+// parsePreviewSize 解析 `宽度:质量` 格式的预览尺寸，质量可省略
+func parsePreviewSize(s string) (appsession.PreviewSize, error) {
+	widthStr, qualityStr, hasQuality := strings.Cut(s, ":")
+	width, err := strconv.Atoi(widthStr)
+	if err != nil || width <= 0 {
+		return appsession.PreviewSize{}, fmt.Errorf("invalid width: %q", widthStr)
+	}
+	var quality int
+	if hasQuality {
+		quality, err = strconv.Atoi(qualityStr)
+		if err != nil || quality < 1 || quality > 100 {
+			return appsession.PreviewSize{}, fmt.Errorf("invalid quality: %q", qualityStr)
+		}
+	}
+	return appsession.PreviewSize{Width: width, Quality: quality}, nil
+}
@@ -13,11 +13,14 @@ import (
 	appdirectory "main/internal/application/directory"
 	appimage "main/internal/application/image"
 	appsession "main/internal/application/session"
+	appsidecar "main/internal/application/sidecar"
 	domdirectory "main/internal/domain/directory"
 	"main/internal/domain/image"
 	"main/internal/domain/session"
+	"main/internal/infrastructure/boltdb"
 	"main/internal/infrastructure/concurrency"
 	"main/internal/infrastructure/ebus"
+	"main/internal/infrastructure/ffmpeg"
 	"main/internal/infrastructure/inmem"
 	"main/internal/infrastructure/localfs"
 	"main/internal/infrastructure/magick"
@@ -47,6 +50,10 @@ const defaultPort = "34898"
 var version = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "sidecar" {
+		os.Exit(runSidecarCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	var (
 		logger *zap.Logger
 		err    error
@@ -66,22 +73,50 @@ func main() {
 	signer := urlconv.NewSigner(cfg.SecretKey, cfg.AbsRootDir)
 
 	sessionRepo := inmem.NewSessionRepository()
-	metadataRepo := xmpsidecar.NewRepository()
+	metadataRepo := xmpsidecar.NewRepository(
+		xmpsidecar.WithSoftwareAgent(xmpsidecar.DefaultSoftwareAgent + " " + version),
+	)
 
 	// Initialize Image Cache and Processor
-	cacheDir := filepath.Join(os.TempDir(), "image-funnel-cache")
-	// Cleanup every 1 hour, remove files older than 24 hours
-	imageCache, cleanupCache := localfs.NewImageCache(cacheDir, time.Hour, 24*time.Hour)
-	defer cleanupCache()
-	magickProcessor := magick.NewProcessor(imageCache, cfg.MagickConcurrency)
-	hybridProcessor := stdimage.NewHybridProcessor(magickProcessor)
+	imageCache, err := localfs.NewImageCache(cfg.CacheDir, cfg.CacheMaxSize, logger)
+	if err != nil {
+		logger.Fatal("failed to initialize image cache", zap.Error(err))
+	}
+	var cacheKeyer appimage.CacheKeyer = localfs.NewPathCacheKeyer()
+	if cfg.CacheContentKey {
+		cacheKeyer = localfs.NewContentCacheKeyer()
+	}
+	formatRegistry := shared.NewFormatRegistry(sourceFormats(logger, cfg.SourceFormats, cfg.FFprobePath, cfg.FFmpegPath), cfg.AnimatedPreview)
+	magickProcessor := magick.NewProcessor(imageCache, cacheKeyer, formatRegistry, cfg.MagickConcurrency, magick.WithStripMetadata(cfg.StripMetadata))
+	nativeProcessor := stdimage.NewProcessor(imageCache, cacheKeyer, cfg.ResizeConcurrency)
+	// 与 ImageMagick 同为外部命令，共用并发配置
+	videoProcessor := ffmpeg.NewProcessor(cfg.FFprobePath, cfg.FFmpegPath, imageCache, cacheKeyer, cfg.MagickConcurrency)
+	hybridProcessor := stdimage.NewHybridProcessor(nativeProcessor, magickProcessor, videoProcessor, formatRegistry)
 	imageProcessor := concurrency.NewSingleFlightImageProcessor(hybridProcessor)
 
-	imageFactory := image.NewFactory(metadataRepo, imageProcessor)
+	imageFactoryOptions := []image.FactoryOption{image.WithLogger(logger)}
+	var imageIndex *boltdb.ImageIndex
+	if cfg.EnableIndex {
+		imageIndex, err = boltdb.OpenImageIndex(cfg.IndexPath, time.Second, logger)
+		if err != nil {
+			// 索引只是缓存，无法打开时（如被其他实例占用）仍然可以运行
+			logger.Warn("failed to open image index, disabled", zap.String("path", cfg.IndexPath), zap.Error(err))
+		} else {
+			defer imageIndex.Close()
+			imageFactoryOptions = append(imageFactoryOptions, image.WithIndex(imageIndex))
+		}
+	}
+	var fingerprinter *localfs.PartialFingerprinter
+	if cfg.ContentIdentity {
+		fingerprinter = localfs.NewPartialFingerprinter()
+		imageFactoryOptions = append(imageFactoryOptions, image.WithFingerprinter(fingerprinter))
+	}
+	imageFactory := image.NewFactory(metadataRepo, imageProcessor, formatRegistry, imageFactoryOptions...)
 	dirRepo := inmem.NewDirectoryRepository(cfg.AbsRootDir)
 	localScanner := localfs.NewScanner(cfg.AbsRootDir, imageFactory, dirRepo)
 
 	sessionTopic, _ := pubsub.NewInMemoryTopic[scalar.ID]()
+	loadProgressTopic, _ := pubsub.NewInMemoryTopic[session.LoadProgressEvent]()
 	fileChangedTopic, _ := pubsub.NewInMemoryTopic[*shared.FileChangedEvent]()
 	eventBus := ebus.NewEventBus(sessionTopic, fileChangedTopic, sessionRepo, appsession.NewSessionDTOFactory(signer))
 
@@ -107,24 +142,126 @@ func main() {
 				}
 
 				cache.Invalidate(relPath)
+
+				// 移动时旧目录的统计也发生了变化
+				if event.OldRelPath != "" {
+					oldRelPath, err := domdirectory.DecodeID(event.OldDirectoryID)
+					if err != nil {
+						logger.Error("failed to decode directory id", zap.Error(err))
+						continue
+					}
+					if oldRelPath != relPath {
+						cache.Invalidate(oldRelPath)
+					}
+				}
+			}
+		}()
+		defer cancel()
+	}
+
+	if imageIndex != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			if count, err := imageIndex.Prune(ctx); err != nil {
+				if ctx.Err() == nil {
+					logger.Warn("failed to prune image index", zap.Error(err))
+				}
+			} else if count > 0 {
+				logger.Info("image index pruned", zap.Int("count", count))
+			}
+		}()
+		go func() {
+			// 修改由读取时的校验处理，删除需要移除记录，避免索引无限增长
+			for event, err := range fileChangedTopic.Subscribe(ctx) {
+				if err != nil {
+					continue
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				switch {
+				case event.Action == shared.FileActionRename && event.OldRelPath != "":
+					// 重命名不改变文件内容，记录随文件迁移
+					oldPath := filepath.Join(cfg.AbsRootDir, event.OldRelPath)
+					if entry := imageIndex.Get(oldPath); entry != nil {
+						imageIndex.Put(filepath.Join(cfg.AbsRootDir, event.RelPath), entry)
+					}
+					imageIndex.Delete(oldPath)
+				case event.Action == shared.FileActionRemove || event.Action == shared.FileActionRename:
+					imageIndex.Delete(filepath.Join(cfg.AbsRootDir, event.RelPath))
+				}
+			}
+		}()
+		defer cancel()
+	}
+
+	if fingerprinter != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			// 删除的文件不再需要记忆的指纹
+			for event, err := range fileChangedTopic.Subscribe(ctx) {
+				if err != nil {
+					continue
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				switch {
+				case event.Action == shared.FileActionRename && event.OldRelPath != "":
+					fingerprinter.Forget(filepath.Join(cfg.AbsRootDir, event.OldRelPath))
+				case event.Action == shared.FileActionRemove || event.Action == shared.FileActionRename:
+					fingerprinter.Forget(filepath.Join(cfg.AbsRootDir, event.RelPath))
+				}
 			}
 		}()
 		defer cancel()
 	}
 
 	fileWatcher := localfs.NewWatcher(logger)
-	_, dirServiceCleanup := domdirectory.NewService(fileWatcher, eventBus, cfg.AbsRootDir, dirRepo, logger)
+	_, dirServiceCleanup := domdirectory.NewService(fileWatcher, eventBus, cfg.AbsRootDir, dirRepo, formatRegistry, logger, domdirectory.WithQuietWindow(cfg.WatchQuietWindow))
 	defer dirServiceCleanup()
 
-	sessionService, sessionCleanup := session.NewService(sessionRepo, metadataRepo, dirScanner, eventBus, logger, sessionTopic, cfg.AbsRootDir)
+	sessionService, sessionCleanup := session.NewService(sessionRepo, metadataRepo, dirScanner, eventBus, logger, sessionTopic, loadProgressTopic, cfg.AbsRootDir)
 	defer sessionCleanup()
 
+	formats := previewFormats(logger, cfg.PreviewFormats)
+	var serverOptions []interfacehttp.ServerOption
+	if cfg.PrewarmCount > 0 {
+		prewarmer, prewarmCleanup := appsession.NewPrewarmer(
+			sessionService,
+			dirScanner,
+			imageProcessor,
+			sessionTopic,
+			appsession.PrewarmOptions{
+				Count:         cfg.PrewarmCount,
+				Sizes:         cfg.PrewarmSizes,
+				ThumbnailSize: appsession.PreviewSize{Width: 256, Quality: 75},
+				Format:        formats[0],
+				IdleTimeout:   cfg.PrewarmIdleTimeout,
+			},
+			logger,
+		)
+		defer prewarmCleanup()
+		serverOptions = append(serverOptions, interfacehttp.WithFormatObserver(prewarmer.RecordRequest))
+	}
+
 	imageDTOFactory := appimage.NewImageDTOFactory(signer)
 
 	sessionHandler := appsession.NewHandler(sessionService, eventBus, signer, logger)
 	directoryHandler := appdirectory.NewHandler(dirScanner, eventBus, imageDTOFactory, dirRepo)
 
-	appRoot := application.NewRoot(sessionHandler, directoryHandler)
+	sidecarHandler := appsidecar.NewHandler(xmpsidecar.NewRecovery(metadataRepo), cfg.AbsRootDir, logger)
+
+	// 占位图保存在索引中，未启用索引时不提供
+	var placeholders appimage.PlaceholderGenerator
+	if imageIndex != nil {
+		placeholderGenerator, placeholderCleanup := stdimage.NewPlaceholderGenerator(imageProcessor, imageIndex, logger)
+		defer placeholderCleanup()
+		placeholders = placeholderGenerator
+	}
+	imageHandler := appimage.NewHandler(imageCache, cacheKeyer, placeholders, cfg.AbsRootDir, logger)
+
+	appRoot := application.NewRoot(sessionHandler, directoryHandler, sidecarHandler, imageHandler)
 
 	resolver := graphql.NewResolver(appRoot, cfg.AbsRootDir, signer, version)
 
@@ -176,11 +313,15 @@ func main() {
 		logger,
 		signer,
 		imageProcessor,
+		hybridProcessor,
 		srv,
 		gui,
 		cfg.AbsRootDir,
 		cfg.FrontendDir,
 		cfg.CorsHosts,
+		formats,
+		formatRegistry,
+		serverOptions...,
 	)
 
 	logger.Fatal("start server", zap.Error(httpServer.Serve(":"+cfg.Port)))
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"main/internal/domain/metadata"
+	"main/internal/infrastructure/xmpsidecar"
+
+	"go.uber.org/zap"
+)
+
+const sidecarUsage = `usage:
+  image-funnel sidecar list [dir]
+  image-funnel sidecar restore|salvage|discard <path>
+
+dir 和 path 相对于 IMAGE_FUNNEL_ROOT_DIR
+`
+
+// runSidecarCommand 执行 sidecar 修复子命令，返回进程退出码
+func runSidecarCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, sidecarUsage)
+		return 2
+	}
+
+	cfg, err := loadConfig(zap.NewNop(), version)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	recovery := xmpsidecar.NewRecovery(xmpsidecar.NewRepository(
+		xmpsidecar.WithSoftwareAgent(xmpsidecar.DefaultSoftwareAgent + " " + version),
+	))
+
+	resolvePath := func(p string) string {
+		if filepath.IsAbs(p) {
+			return p
+		}
+		return filepath.Join(cfg.AbsRootDir, p)
+	}
+
+	switch args[0] {
+	case "list":
+		dir := cfg.AbsRootDir
+		if len(args) > 1 {
+			dir = resolvePath(args[1])
+		}
+		w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "KIND\tPATH\tRATING\tERROR")
+		for issue, err := range recovery.Scan(context.Background(), dir) {
+			if err != nil {
+				w.Flush()
+				fmt.Fprintln(stderr, err)
+				return 1
+			}
+			rating := "-"
+			if v := issue.SalvageableRating(); v != nil {
+				rating = strconv.Itoa(*v)
+			}
+			var errMessage string
+			if issue.Err() != nil {
+				errMessage = issue.Err().Error()
+			}
+			relPath, err := filepath.Rel(cfg.AbsRootDir, issue.Path())
+			if err != nil {
+				relPath = issue.Path()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", issue.Kind(), relPath, rating, errMessage)
+		}
+		w.Flush()
+		return 0
+	case "restore", "salvage", "discard":
+		if len(args) != 2 {
+			fmt.Fprint(stderr, sidecarUsage)
+			return 2
+		}
+		path := resolvePath(args[1])
+		issue, err := recovery.Inspect(path)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		if issue == nil {
+			fmt.Fprintf(stderr, "no sidecar issue found: %s\n", path)
+			return 1
+		}
+		if err := resolveIssue(recovery, issue, args[0], stdout); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		return 0
+	default:
+		fmt.Fprint(stderr, sidecarUsage)
+		return 2
+	}
+}
+
+func resolveIssue(recovery metadata.Recovery, issue *metadata.SidecarIssue, action string, stdout io.Writer) error {
+	switch action {
+	case "restore":
+		if err := recovery.Restore(issue); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "restored: %s.xmp\n", issue.ImagePath())
+	case "salvage":
+		rating, err := recovery.Salvage(issue)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "salvaged rating %d: %s.xmp\n", rating, issue.ImagePath())
+	case "discard":
+		if err := recovery.Discard(issue); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "discarded: %s\n", issue.Path())
+	}
+	return nil
+}
@@ -3,6 +3,11 @@ package main
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"os/exec"
+
+	"main/internal/shared"
+
+	"go.uber.org/zap"
 )
 
 func mustGenerateRandomSecretKey() string {
@@ -13,3 +18,50 @@ func mustGenerateRandomSecretKey() string {
 	}
 	return base64.StdEncoding.EncodeToString(key)
 }
+
+// previewFormats 未安装 ImageMagick 时只能使用内置处理输出 JPEG
+func previewFormats(logger *zap.Logger, formats []shared.ImageFormat) []shared.ImageFormat {
+	if _, err := exec.LookPath("magick"); err == nil {
+		return formats
+	}
+	logger.Warn("ImageMagick not found, preview format negotiation disabled", zap.Stringers("configured", formats))
+	return []shared.ImageFormat{shared.ImageFormatJPEG}
+}
+
+// sourceFormats 未安装 ImageMagick 时只支持可以使用纯 Go 解码的格式，未安装 ffprobe/ffmpeg 时不支持视频
+func sourceFormats(logger *zap.Logger, formats []shared.SourceFormat, ffprobe, ffmpeg string) []shared.SourceFormat {
+	_, magickErr := exec.LookPath("magick")
+	hasMagick := magickErr == nil
+	hasFFmpeg := commandExists(ffprobe) && commandExists(ffmpeg)
+
+	var result []shared.SourceFormat
+	var skipped []shared.SourceFormat
+	var skippedVideo []shared.SourceFormat
+	for _, format := range formats {
+		meta := format.Meta()
+		switch {
+		case meta.Video && !hasFFmpeg:
+			skippedVideo = append(skippedVideo, format)
+		case !meta.Video && !meta.Native && !hasMagick:
+			skipped = append(skipped, format)
+		default:
+			result = append(result, format)
+		}
+	}
+	if len(skipped) > 0 {
+		logger.Warn("ImageMagick not found, source formats disabled", zap.Stringers("formats", skipped))
+	}
+	if len(skippedVideo) > 0 {
+		logger.Warn("ffprobe or ffmpeg not found, video formats disabled",
+			zap.String("ffprobe", ffprobe),
+			zap.String("ffmpeg", ffmpeg),
+			zap.Stringers("formats", skippedVideo),
+		)
+	}
+	return result
+}
+
+func commandExists(name string) bool {
+	_, err := exec.LookPath(name)
+	return err == nil
+}
@@ -1,7 +1,19 @@
-import { computed, type Ref, toValue, type MaybeRefOrGetter } from "vue";
+import {
+  computed,
+  shallowRef,
+  type Ref,
+  toValue,
+  type MaybeRefOrGetter,
+} from "vue";
 import useQuery from "@/graphql/utils/useQuery";
 import useSubscription from "@/graphql/utils/useSubscription";
-import { SessionDocument, SessionUpdatedDocument } from "@/graphql/generated";
+import {
+  SessionDocument,
+  SessionLoadProgressDocument,
+  SessionLoadState,
+  SessionUpdatedDocument,
+  type SessionLoadProgressFragment,
+} from "@/graphql/generated";
 
 export default function useSession(
   id: MaybeRefOrGetter<string>,
@@ -18,8 +30,42 @@ export default function useSession(
 
   const session = computed(() => data.value?.session);
 
+  // 加载期间会话只在队列从空变为非空和加载结束时更新，进度单独订阅
+  const latestLoadProgress = shallowRef<SessionLoadProgressFragment>();
+  useSubscription(SessionLoadProgressDocument, {
+    variables: () => {
+      const v = session.value;
+      if (v?.loadProgress.state !== SessionLoadState.LOADING) {
+        return undefined;
+      }
+      return { id: v.id };
+    },
+    onNext: (result) => {
+      const progress = result.data?.sessionLoadProgress;
+      if (progress) {
+        latestLoadProgress.value = progress;
+      }
+    },
+  });
+
+  const loadProgress = computed(() => {
+    const v = session.value;
+    if (!v) {
+      return undefined;
+    }
+    const latest = latestLoadProgress.value;
+    if (
+      latest?.sessionId === v.id &&
+      v.loadProgress.state === SessionLoadState.LOADING
+    ) {
+      return latest;
+    }
+    return v.loadProgress;
+  });
+
   return {
     session,
+    loadProgress,
     data,
   };
 }
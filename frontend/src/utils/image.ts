@@ -23,3 +23,12 @@ export function getImageUrlByZoom(
   }
   return image.url;
 }
+
+/**
+ * 为图片地址追加处理优先级，数值越小越优先。
+ *
+ * `<img>` 无法设置请求头，优先级通过不参与签名和缓存键的 `p` 参数传递。
+ */
+export function withImagePriority(url: string, priority: number): string {
+  return `${url}${url.includes("?") ? "&" : "?"}p=${priority}`;
+}
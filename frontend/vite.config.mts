@@ -22,6 +22,12 @@ export default defineConfig({
       "/image": {
         target: "http://127.0.0.1:8000",
       },
+      "/tile": {
+        target: "http://127.0.0.1:8000",
+      },
+      "/video": {
+        target: "http://127.0.0.1:8000",
+      },
     },
   },
   optimizeDeps: {
@@ -1,8 +1,25 @@
 package image
 
+import (
+	"os"
+)
+
 type Cache interface {
 	// GetPath returns the absolute path for the given key
 	GetPath(key string) string
-	// Exists checks if the cache key exists and updates access time
-	Exists(key string) bool
+	// Lookup returns the path of the first existing key and updates its access time,
+	// keys are alternatives for one entry (e.g. possible extensions) and count as one lookup
+	Lookup(keys ...string) (string, bool)
+	// Save atomically writes the entry and returns its path, may evict least recently used entries
+	Save(key string, write func(file *os.File) error) (string, error)
+}
+
+// CacheKeyer 生成处理结果的缓存键
+//
+// 缓存键的第一级为源图片的命名空间，同一源图片的所有输出位于同一命名空间下，用于按源图片清除缓存
+type CacheKeyer interface {
+	// Key 返回源图片按 params 处理后的缓存键，源图片变化后缓存键随之变化
+	Key(srcPath, params string) (string, error)
+	// Namespace 返回源图片当前的命名空间
+	Namespace(srcPath string) (string, error)
 }
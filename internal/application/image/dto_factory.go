@@ -2,6 +2,7 @@ package image
 
 import (
 	"main/internal/domain/image"
+	"main/internal/scalar"
 	"main/internal/shared"
 )
 
@@ -26,5 +27,47 @@ func (f *ImageDTOFactory) New(img *image.Image) (*shared.ImageDTO, error) {
 		Width:         img.Width(),
 		Height:        img.Height(),
 		XMPExists:     img.XMPExists(),
+		Note:          img.Note(),
+		History:       newHistoryDTOs(img),
+		XMPError:      errorMessage(img.XMPError()),
+		MediaType:     img.MediaType(),
+		Duration:      videoDuration(img),
+		Orientation:   img.Orientation(),
+		// sidecar 中设置的方向需要随预览地址传递给处理器
+		OrientationOverride: img.XMPData().Orientation(),
 	}, nil
 }
+
+func videoDuration(img *image.Image) *scalar.Duration {
+	if !img.IsVideo() {
+		return nil
+	}
+	d := scalar.DurationFromStandard(img.Duration())
+	return &d
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func newHistoryDTOs(img *image.Image) []*shared.ImageHistoryEventDTO {
+	history := img.History()
+	result := make([]*shared.ImageHistoryEventDTO, 0, len(history))
+	for _, event := range history {
+		dto := &shared.ImageHistoryEventDTO{
+			Action:        event.Action,
+			Rating:        event.Rating,
+			Timestamp:     event.Timestamp,
+			SoftwareAgent: event.SoftwareAgent,
+		}
+		if event.SessionID != "" {
+			id := scalar.ToID(event.SessionID)
+			dto.SessionID = &id
+		}
+		result = append(result, dto)
+	}
+	return result
+}
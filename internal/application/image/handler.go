@@ -0,0 +1,94 @@
+package image
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"main/internal/shared"
+	"main/internal/util"
+
+	"go.uber.org/zap"
+)
+
+// CacheManager 管理处理后图片的缓存
+type CacheManager interface {
+	Stats() *shared.ImageCacheStatsDTO
+	// Purge 删除命名空间下的缓存，namespace 为空时删除全部缓存，返回删除的缓存项数量
+	Purge(namespace string) (int, error)
+}
+
+// Handler 图片缓存管理及占位图应用层处理器
+type Handler struct {
+	cache        CacheManager
+	keyer        CacheKeyer
+	placeholders PlaceholderGenerator
+	rootDir      string
+	logger       *zap.Logger
+}
+
+// NewHandler 创建图片处理器，placeholders 为 nil 时不提供占位图
+func NewHandler(cache CacheManager, keyer CacheKeyer, placeholders PlaceholderGenerator, rootDir string, logger *zap.Logger) *Handler {
+	return &Handler{
+		cache:        cache,
+		keyer:        keyer,
+		placeholders: placeholders,
+		rootDir:      rootDir,
+		logger:       logger,
+	}
+}
+
+// ImagePlaceholder 返回图片的占位图，orientation 为 sidecar 中设置的方向，0 表示未设置
+//
+// 占位图只用于改善加载体验，在后台生成，尚未生成或未启用时返回 nil
+func (h *Handler) ImagePlaceholder(ctx context.Context, img *shared.ImageDTO) (*string, error) {
+	if h.placeholders == nil {
+		return nil, nil
+	}
+	if img.OrientationOverride != 0 {
+		ctx = ContextWithOrientation(ctx, img.OrientationOverride)
+	}
+	hash, ok := h.placeholders.Placeholder(ctx, img.Path)
+	if !ok {
+		return nil, nil
+	}
+	return &hash, nil
+}
+
+// ImageCacheStats 返回图片缓存统计
+func (h *Handler) ImageCacheStats(ctx context.Context) (*shared.ImageCacheStatsDTO, error) {
+	return h.cache.Stats(), nil
+}
+
+// PurgeImageCache 清除图片缓存，relPath 不为空时只清除该源图片的缓存，返回删除的缓存项数量
+func (h *Handler) PurgeImageCache(ctx context.Context, relPath string) (count int, err error) {
+	startTime := time.Now()
+
+	defer func() {
+		if err != nil {
+			h.logger.Error("purge image cache",
+				zap.String("path", relPath),
+				zap.Duration("duration", time.Since(startTime)),
+				zap.Error(err),
+			)
+		} else {
+			h.logger.Info("purge image cache",
+				zap.String("path", relPath),
+				zap.Int("count", count),
+				zap.Duration("duration", time.Since(startTime)),
+			)
+		}
+	}()
+
+	if relPath == "" {
+		return h.cache.Purge("")
+	}
+	if err := util.EnsurePathInRoot(h.rootDir, relPath); err != nil {
+		return 0, err
+	}
+	namespace, err := h.keyer.Namespace(filepath.Join(h.rootDir, filepath.FromSlash(relPath)))
+	if err != nil {
+		return 0, err
+	}
+	return h.cache.Purge(namespace)
+}
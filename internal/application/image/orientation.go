@@ -0,0 +1,20 @@
+package image
+
+import "context"
+
+type orientationContextKey struct{}
+
+// ContextWithOrientation 指定处理时使用的方向（1-8），覆盖原图中的方向
+//
+// 用于应用 XMP sidecar 中设置的方向，处理器需要将其计入缓存键
+func ContextWithOrientation(ctx context.Context, orientation int) context.Context {
+	return context.WithValue(ctx, orientationContextKey{}, orientation)
+}
+
+// OrientationFromContext 返回指定的方向，未指定时为 0，表示使用原图中的方向
+func OrientationFromContext(ctx context.Context) int {
+	if orientation, ok := ctx.Value(orientationContextKey{}).(int); ok && orientation >= 1 && orientation <= 8 {
+		return orientation
+	}
+	return 0
+}
@@ -0,0 +1,11 @@
+package image
+
+import "context"
+
+// PlaceholderGenerator 生成预览图加载前显示的占位图
+type PlaceholderGenerator interface {
+	// Placeholder 返回 Base64 编码的 ThumbHash，context 中指定的方向优先于原图中的方向
+	//
+	// 占位图在后台生成，尚未生成时返回 false
+	Placeholder(ctx context.Context, srcPath string) (string, bool)
+}
@@ -0,0 +1,36 @@
+package image
+
+import "context"
+
+const (
+	// PriorityCurrent 是正在查看的图片的优先级，也是未指定时的默认值
+	PriorityCurrent = 0
+	// PriorityBackground 是后台预热的优先级
+	//
+	// 队列中的后续图片按与当前图片的距离使用 1、2、3……
+	PriorityBackground = 100
+)
+
+type priorityContextKey struct{}
+
+// ContextWithPriority 设置图片处理的优先级，数值越小越优先
+func ContextWithPriority(ctx context.Context, priority int) context.Context {
+	return ContextWithDynamicPriority(ctx, func() int {
+		return priority
+	})
+}
+
+// ContextWithDynamicPriority 设置排队期间可能变化的优先级
+//
+// 用于合并请求时按最高优先级的调用方排队
+func ContextWithDynamicPriority(ctx context.Context, priority func() int) context.Context {
+	return context.WithValue(ctx, priorityContextKey{}, priority)
+}
+
+// PriorityFromContext 返回图片处理的优先级，未设置时为 PriorityCurrent
+func PriorityFromContext(ctx context.Context) int {
+	if priority, ok := ctx.Value(priorityContextKey{}).(func() int); ok {
+		return priority()
+	}
+	return PriorityCurrent
+}
@@ -9,7 +9,8 @@ import (
 type Processor interface {
 	// Process returns the path to the processed image.
 	// If width and quality are 0, it returns the original path.
-	Process(ctx context.Context, srcPath string, width, quality int) (string, error)
+	// format is a preference, the extension of the returned path reflects the actual output format.
+	Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error)
 
 	Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error)
 }
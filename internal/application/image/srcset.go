@@ -0,0 +1,39 @@
+package image
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DefaultSrcsetWidths 是未指定宽度时使用的宽度阶梯，覆盖常见的屏幕宽度和像素比
+var DefaultSrcsetWidths = []int{320, 640, 960, 1280, 1920, 2560, 3840}
+
+// SrcsetWidths 返回升序排列且不重复的宽度，用于生成 srcset
+//
+// 不放大图片：不小于原图宽度的宽度合并为原图宽度，原图尺寸未知时返回 nil。
+// 相同的输入总是得到相同的结果，保证生成的地址可以命中浏览器缓存
+func SrcsetWidths(widths []int, originalWidth int) []int {
+	if originalWidth <= 0 {
+		return nil
+	}
+	if widths == nil {
+		widths = DefaultSrcsetWidths
+	}
+	result := make([]int, 0, len(widths))
+	for _, w := range widths {
+		if w <= 0 {
+			continue
+		}
+		result = append(result, min(w, originalWidth))
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// SrcsetSizes 返回与 srcset 匹配的 sizes 属性，图片最多占满视口宽度且不超过原图宽度
+func SrcsetSizes(originalWidth int) string {
+	if originalWidth <= 0 {
+		return "100vw"
+	}
+	return fmt.Sprintf("(max-width: %dpx) 100vw, %dpx", originalWidth, originalWidth)
+}
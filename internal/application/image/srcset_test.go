@@ -0,0 +1,54 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSrcsetWidths(t *testing.T) {
+	testCases := []struct {
+		name          string
+		widths        []int
+		originalWidth int
+		want          []int
+	}{
+		{"default widths", nil, 4000, DefaultSrcsetWidths},
+		{"clamp to original width", nil, 1000, []int{320, 640, 960, 1000}},
+		{"original smaller than all widths", nil, 200, []int{200}},
+		{"sort and dedup", []int{800, 400, 400, 2000, 3000}, 1000, []int{400, 800, 1000}},
+		{"skip invalid widths", []int{0, -1, 500}, 1000, []int{500}},
+		{"only invalid widths", []int{0, -100}, 1000, []int{}},
+		{"empty widths", []int{}, 1000, []int{}},
+		{"unknown original width", []int{400, 800}, 0, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SrcsetWidths(tc.widths, tc.originalWidth))
+		})
+	}
+}
+
+func TestSrcsetWidths_DoesNotModifyInput(t *testing.T) {
+	widths := []int{800, 400}
+	SrcsetWidths(widths, 1000)
+	assert.Equal(t, []int{800, 400}, widths)
+	assert.Equal(t, []int{320, 640, 960, 1280, 1920, 2560, 3840}, DefaultSrcsetWidths)
+}
+
+func TestSrcsetSizes(t *testing.T) {
+	testCases := []struct {
+		name          string
+		originalWidth int
+		want          string
+	}{
+		{"known width", 1000, "(max-width: 1000px) 100vw, 1000px"},
+		{"unknown width", 0, "100vw"},
+		{"invalid width", -1, "100vw"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SrcsetSizes(tc.originalWidth))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+)
+
+const (
+	// TileSize 是瓦片边长（不含重叠部分）
+	TileSize = 512
+	// TileOverlap 是瓦片每侧与相邻瓦片重叠的像素数，避免缩放显示时出现接缝
+	TileOverlap = 1
+)
+
+// ErrTileNotFound 表示请求的层级或行列超出范围
+var ErrTileNotFound = errors.New("tile not found")
+
+// TileProcessor 生成 Deep Zoom（DZI）瓦片
+type TileProcessor interface {
+	// Tile 返回瓦片路径，返回路径的扩展名表示实际格式
+	//
+	// level 为 0 时整张图缩小为 1x1，为 TileMaxLevel 时为原始尺寸
+	Tile(ctx context.Context, srcPath string, tileSize, level, col, row int) (string, error)
+}
+
+// TileMaxLevel 返回 DZI 的最大层级
+func TileMaxLevel(width, height int) int {
+	size := max(width, height)
+	if size <= 1 {
+		return 0
+	}
+	return bits.Len(uint(size - 1))
+}
+
+// TileLevelSize 返回指定层级的图片尺寸
+func TileLevelSize(width, height, level int) (int, int) {
+	scale := TileMaxLevel(width, height) - level
+	if scale <= 0 {
+		return width, height
+	}
+	divisor := 1 << scale
+	return max(1, (width+divisor-1)/divisor), max(1, (height+divisor-1)/divisor)
+}
+
+// TileInRange 判断瓦片是否在指定尺寸图片的层级和行列范围内
+func TileInRange(width, height, tileSize, level, col, row int) bool {
+	if tileSize <= 0 || level < 0 || col < 0 || row < 0 || level > TileMaxLevel(width, height) {
+		return false
+	}
+	levelWidth, levelHeight := TileLevelSize(width, height, level)
+	return col*tileSize < levelWidth && row*tileSize < levelHeight
+}
@@ -3,6 +3,8 @@ package image
 import (
 	"fmt"
 	"net/url"
+
+	"main/internal/shared"
 )
 
 type SignOption func(url.Values)
@@ -19,6 +21,20 @@ func WithQuality(q int) SignOption {
 	}
 }
 
+// WithFormat 指定输出格式，不指定时由服务端根据 Accept 协商
+func WithFormat(f shared.ImageFormat) SignOption {
+	return func(v url.Values) {
+		v.Set("f", f.String())
+	}
+}
+
+// WithOrientation 指定方向（1-8），覆盖原图中的方向
+func WithOrientation(o int) SignOption {
+	return func(v url.Values) {
+		v.Set("o", fmt.Sprintf("%d", o))
+	}
+}
+
 type URLSigner interface {
 	GenerateSignedURL(path string, opts ...SignOption) (string, error)
 }
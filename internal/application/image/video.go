@@ -0,0 +1,14 @@
+package image
+
+import (
+	"context"
+
+	"main/internal/shared"
+)
+
+// VideoProcessor 读取视频信息并提取封面
+type VideoProcessor interface {
+	Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error)
+	// Poster 返回视频封面（原始尺寸的 JPEG）的路径
+	Poster(ctx context.Context, srcPath string) (string, error)
+}
@@ -2,25 +2,35 @@ package application
 
 import (
 	"main/internal/application/directory"
+	"main/internal/application/image"
 	"main/internal/application/session"
+	"main/internal/application/sidecar"
 )
 
 type sessionHandler = session.Handler
 type directoryHandler = directory.Handler
+type sidecarHandler = sidecar.Handler
+type imageHandler = image.Handler
 
 // Root 直接嵌入了Handler，可以使用所有Handler方法
 // 所有方法通过嵌入的Handler直接访问，不允许在Root结构体上重新声明
 type Root struct {
 	*sessionHandler
 	*directoryHandler
+	*sidecarHandler
+	*imageHandler
 }
 
 func NewRoot(
 	sessionHandler *session.Handler,
 	directoryHandler *directory.Handler,
+	sidecarHandler *sidecar.Handler,
+	imageHandler *image.Handler,
 ) *Root {
 	return &Root{
 		sessionHandler:   sessionHandler,
 		directoryHandler: directoryHandler,
+		sidecarHandler:   sidecarHandler,
+		imageHandler:     imageHandler,
 	}
 }
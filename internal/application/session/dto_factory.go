@@ -2,7 +2,9 @@ package session
 
 import (
 	appimage "main/internal/application/image"
+	"main/internal/domain/image"
 	"main/internal/domain/session"
+	"main/internal/scalar"
 	"main/internal/shared"
 )
 
@@ -17,15 +19,13 @@ func NewSessionDTOFactory(urlSigner appimage.URLSigner) *SessionDTOFactory {
 }
 
 func (f *SessionDTOFactory) New(sess *session.Session) (*shared.SessionDTO, error) {
-	imageDTOFactory := appimage.NewImageDTOFactory(f.urlSigner)
-
 	// 只计算一次统计信息
 	sessionStats := sess.Stats()
 
 	var currentImage *shared.ImageDTO
 	var err error
 	if img := sess.CurrentImage(); img != nil {
-		currentImage, err = imageDTOFactory.New(img)
+		currentImage, err = f.NewImage(sess, img)
 		if err != nil {
 			return nil, err
 		}
@@ -44,5 +44,39 @@ func (f *SessionDTOFactory) New(sess *session.Session) (*shared.SessionDTO, erro
 		CurrentIndex: sess.CurrentIndex(),
 		CurrentSize:  sess.CurrentSize(),
 		CurrentImage: currentImage,
+		LoadProgress: newLoadProgressDTO(sess),
 	}, nil
 }
+
+func newLoadProgressDTO(sess *session.Session) *shared.SessionLoadProgressDTO {
+	return NewLoadProgressDTO(sess.ID(), sess.LoadProgress())
+}
+
+// NewLoadProgressDTO 创建会话加载进度 DTO
+func NewLoadProgressDTO(sessionID scalar.ID, progress session.LoadProgress) *shared.SessionLoadProgressDTO {
+	dto := &shared.SessionLoadProgressDTO{
+		SessionID:      sessionID,
+		State:          progress.State,
+		FilesSeen:      progress.FilesSeen,
+		ImagesAccepted: progress.ImagesAccepted,
+	}
+	if progress.Err != nil {
+		message := progress.Err.Error()
+		dto.Error = &message
+	}
+	return dto
+}
+
+// NewImage 创建会话中的图片 DTO
+//
+// 会话中尚未提交的备注优先于 XMP 中已有的备注
+func (f *SessionDTOFactory) NewImage(sess *session.Session, img *image.Image) (*shared.ImageDTO, error) {
+	dto, err := appimage.NewImageDTOFactory(f.urlSigner).New(img)
+	if err != nil {
+		return nil, err
+	}
+	if note, ok := sess.ImageNote(img.ID()); ok {
+		dto.Note = note
+	}
+	return dto, nil
+}
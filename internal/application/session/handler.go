@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"iter"
 	appimage "main/internal/application/image"
+	"main/internal/domain/image"
 	"main/internal/domain/session"
 	"main/internal/scalar"
 	"main/internal/shared"
@@ -69,6 +70,28 @@ func (h *Handler) CreateSession(
 	return h.sessionService.Create(ctx, id, directoryId, filter, target_keep)
 }
 
+// CancelSessionCreation 取消会话的目录扫描，已扫描到的图片保留在会话中
+func (h *Handler) CancelSessionCreation(ctx context.Context, sessionID scalar.ID) (err error) {
+	startTime := time.Now()
+
+	defer func() {
+		if err != nil {
+			h.logger.Error("cancel session creation",
+				zap.Stringer("sessionID", sessionID),
+				zap.Duration("duration", time.Since(startTime)),
+				zap.Error(err),
+			)
+		} else {
+			h.logger.Info("cancel session creation",
+				zap.Stringer("sessionID", sessionID),
+				zap.Duration("duration", time.Since(startTime)),
+			)
+		}
+	}()
+
+	return h.sessionService.CancelCreation(ctx, sessionID)
+}
+
 func (h *Handler) MarkImage(
 	ctx context.Context,
 	sessionID scalar.ID,
@@ -100,6 +123,66 @@ func (h *Handler) MarkImage(
 	return h.sessionService.MarkImage(ctx, sessionID, imageID, action, options...)
 }
 
+// SetImageNote 设置图片备注
+func (h *Handler) SetImageNote(
+	ctx context.Context,
+	sessionID scalar.ID,
+	imageID scalar.ID,
+	note string,
+) (err error) {
+	startTime := time.Now()
+
+	defer func() {
+		if err != nil {
+			h.logger.Error("set image note",
+				zap.Stringer("sessionID", sessionID),
+				zap.Stringer("imageID", imageID),
+				zap.Duration("duration", time.Since(startTime)),
+				zap.Error(err),
+			)
+		} else {
+			h.logger.Info("set image note",
+				zap.Stringer("sessionID", sessionID),
+				zap.Stringer("imageID", imageID),
+				zap.Duration("duration", time.Since(startTime)),
+			)
+		}
+	}()
+
+	return h.sessionService.SetImageNote(ctx, sessionID, imageID, note)
+}
+
+// SetImageOrientation 设置图片方向，0 表示清除
+func (h *Handler) SetImageOrientation(
+	ctx context.Context,
+	sessionID scalar.ID,
+	imageID scalar.ID,
+	orientation int,
+) (err error) {
+	startTime := time.Now()
+
+	defer func() {
+		if err != nil {
+			h.logger.Error("set image orientation",
+				zap.Stringer("sessionID", sessionID),
+				zap.Stringer("imageID", imageID),
+				zap.Int("orientation", orientation),
+				zap.Duration("duration", time.Since(startTime)),
+				zap.Error(err),
+			)
+		} else {
+			h.logger.Info("set image orientation",
+				zap.Stringer("sessionID", sessionID),
+				zap.Stringer("imageID", imageID),
+				zap.Int("orientation", orientation),
+				zap.Duration("duration", time.Since(startTime)),
+			)
+		}
+	}()
+
+	return h.sessionService.SetImageOrientation(ctx, sessionID, imageID, orientation)
+}
+
 func (h *Handler) Undo(ctx context.Context, sessionID scalar.ID) (err error) {
 	startTime := time.Now()
 	defer func() {
@@ -126,12 +209,14 @@ func (h *Handler) Commit(
 	keepRating int,
 	shelveRating int,
 	rejectRating int,
+	conflictPolicy shared.XMPConflictPolicy,
 ) (success int, err error) {
 	h.logger.Info("will commit session",
 		zap.Stringer("sessionID", sessionID),
 		zap.Int("keepRating", keepRating),
 		zap.Int("shelveRating", shelveRating),
 		zap.Int("rejectRating", rejectRating),
+		zap.Stringer("conflictPolicy", conflictPolicy),
 	)
 	startTime := time.Now()
 
@@ -159,9 +244,10 @@ func (h *Handler) Commit(
 	defer release()
 
 	writeActions := &shared.WriteActions{
-		KeepRating:   keepRating,
-		ShelveRating: shelveRating,
-		RejectRating: rejectRating,
+		KeepRating:     keepRating,
+		ShelveRating:   shelveRating,
+		RejectRating:   rejectRating,
+		ConflictPolicy: conflictPolicy,
 	}
 	return h.sessionService.Commit(ctx, sess, writeActions)
 }
@@ -188,8 +274,7 @@ func (h *Handler) CurrentImage(ctx context.Context, sessionID scalar.ID) (*share
 		return nil, nil
 	}
 
-	imageDTOFactory := appimage.NewImageDTOFactory(h.urlSigner)
-	return imageDTOFactory.New(img)
+	return h.dtoFactory.NewImage(sess, img)
 }
 
 func (h *Handler) SessionStats(ctx context.Context, sessionID scalar.ID) (*shared.StatsDTO, error) {
@@ -206,6 +291,23 @@ func (h *Handler) SubscribeSession(ctx context.Context) iter.Seq2[*shared.Sessio
 	return h.eventBus.SubscribeSession(ctx)
 }
 
+// SubscribeSessionLoadProgress 订阅会话的加载进度，首先返回当前进度，加载结束后结束
+func (h *Handler) SubscribeSessionLoadProgress(ctx context.Context, sessionID scalar.ID) iter.Seq2[*shared.SessionLoadProgressDTO, error] {
+	return func(yield func(*shared.SessionLoadProgressDTO, error) bool) {
+		for progress, err := range h.sessionService.SubscribeLoadProgress(ctx, sessionID) {
+			if err != nil {
+				if !yield(nil, err) {
+					return
+				}
+				continue
+			}
+			if !yield(NewLoadProgressDTO(sessionID, progress), nil) {
+				return
+			}
+		}
+	}
+}
+
 func (h *Handler) NextImages(ctx context.Context, sessionID scalar.ID, count int) ([]*shared.ImageDTO, error) {
 	sess, release, err := h.sessionService.Acquire(ctx, sessionID)
 	if err != nil {
@@ -217,11 +319,12 @@ func (h *Handler) NextImages(ctx context.Context, sessionID scalar.ID, count int
 	if len(images) == 0 {
 		return nil, nil
 	}
+	// 并发探测尺寸，避免逐个构建 DTO 时串行等待
+	image.ResolveMeta(images)
 
-	imageDTOFactory := appimage.NewImageDTOFactory(h.urlSigner)
 	result := make([]*shared.ImageDTO, 0, len(images))
 	for _, img := range images {
-		dto, err := imageDTOFactory.New(img)
+		dto, err := h.dtoFactory.NewImage(sess, img)
 		if err != nil {
 			return nil, err
 		}
@@ -241,11 +344,12 @@ func (h *Handler) KeptImages(ctx context.Context, sessionID scalar.ID, limit, of
 	if len(images) == 0 {
 		return nil, nil
 	}
+	// 并发探测尺寸，避免逐个构建 DTO 时串行等待
+	image.ResolveMeta(images)
 
-	imageDTOFactory := appimage.NewImageDTOFactory(h.urlSigner)
 	result := make([]*shared.ImageDTO, 0, len(images))
 	for _, img := range images {
-		dto, err := imageDTOFactory.New(img)
+		dto, err := h.dtoFactory.NewImage(sess, img)
 		if err != nil {
 			return nil, err
 		}
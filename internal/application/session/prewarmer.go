@@ -0,0 +1,279 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	appimage "main/internal/application/image"
+	"main/internal/domain/directory"
+	"main/internal/domain/image"
+	"main/internal/domain/session"
+	"main/internal/pubsub"
+	"main/internal/scalar"
+	"main/internal/shared"
+
+	"go.uber.org/zap"
+)
+
+// PreviewSize 预览图尺寸，与前端请求的 url(width, quality) 对应
+type PreviewSize struct {
+	Width   int
+	Quality int
+}
+
+// PrewarmOptions 预热配置
+type PrewarmOptions struct {
+	Count         int                // 预热当前图片之后的图片数量
+	Sizes         []PreviewSize      // 队列中图片的预览尺寸
+	ThumbnailSize PreviewSize        // 目录最新图片的缩略图尺寸
+	Format        shared.ImageFormat // 客户端还没有请求过预览图时使用的输出格式
+	IdleTimeout   time.Duration      // 会话超过该时间没有更新后停止预热
+}
+
+// SessionAcquirer 获取会话并持有会话锁
+type SessionAcquirer interface {
+	Acquire(ctx context.Context, id scalar.ID) (*session.Session, func(), error)
+}
+
+// Prewarmer 在服务端为活跃会话预先生成预览图
+//
+// 订阅会话保存事件，以后台优先级处理队列中后续图片的预览图及目录最新图片的缩略图，
+// 会话空闲后停止。输出格式使用会话的客户端最近请求预览图时协商出的格式
+type Prewarmer struct {
+	sessionService SessionAcquirer
+	dirScanner     directory.Scanner
+	processor      appimage.Processor
+	opts           PrewarmOptions
+	logger         *zap.Logger
+
+	mu         sync.Mutex
+	jobs       map[scalar.ID]*prewarmJob
+	lastFormat shared.ImageFormat // 任意客户端最近请求的格式，用于新的任务
+}
+
+// prewarmJob 单个会话的预热任务，会话有更新时唤醒，空闲超时后取消
+type prewarmJob struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	idle   *time.Timer
+	wake   chan struct{}
+
+	// 以下字段由 Prewarmer.mu 保护
+	paths  map[string]struct{} // 正在预热的图片，用于识别该会话客户端的请求
+	format shared.ImageFormat
+}
+
+// NewPrewarmer 创建预热器并开始订阅会话保存事件
+func NewPrewarmer(
+	sessionService SessionAcquirer,
+	dirScanner directory.Scanner,
+	processor appimage.Processor,
+	sessionSaved pubsub.Topic[scalar.ID],
+	opts PrewarmOptions,
+	logger *zap.Logger,
+) (*Prewarmer, func()) {
+	p := &Prewarmer{
+		sessionService: sessionService,
+		dirScanner:     dirScanner,
+		processor:      processor,
+		opts:           opts,
+		logger:         logger,
+		jobs:           make(map[scalar.ID]*prewarmJob),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for id, err := range sessionSaved.Subscribe(ctx) {
+			if err != nil {
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			p.notify(ctx, id)
+		}
+	}()
+
+	return p, cancel
+}
+
+// notify 唤醒会话的预热任务，没有正在运行的任务时创建
+func (p *Prewarmer) notify(ctx context.Context, id scalar.ID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job, ok := p.jobs[id]
+	if !ok || job.ctx.Err() != nil {
+		jobCtx, cancel := context.WithCancel(appimage.ContextWithPriority(ctx, appimage.PriorityBackground))
+		job = &prewarmJob{
+			ctx:    jobCtx,
+			cancel: cancel,
+			idle:   time.AfterFunc(p.opts.IdleTimeout, cancel),
+			wake:   make(chan struct{}, 1),
+			format: p.lastFormat,
+		}
+		p.jobs[id] = job
+		go p.run(id, job)
+	} else {
+		job.idle.Reset(p.opts.IdleTimeout)
+	}
+
+	select {
+	case job.wake <- struct{}{}:
+	default:
+	}
+}
+
+// RecordRequest 记录客户端请求预览图时协商出的格式
+//
+// 请求的图片正在被某个会话预热时，该会话之后使用这一格式
+func (p *Prewarmer) RecordRequest(path string, format shared.ImageFormat) {
+	if format.IsZero() {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastFormat = format
+	for _, job := range p.jobs {
+		if _, ok := job.paths[path]; ok {
+			job.format = format
+		}
+	}
+}
+
+// format 返回任务使用的输出格式，并记录正在预热的图片
+func (p *Prewarmer) format(job *prewarmJob, images []*image.Image) shared.ImageFormat {
+	paths := make(map[string]struct{}, len(images))
+	for _, img := range images {
+		paths[img.Path()] = struct{}{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	job.paths = paths
+	if job.format.IsZero() {
+		return p.opts.Format
+	}
+	return job.format
+}
+
+func (p *Prewarmer) run(id scalar.ID, job *prewarmJob) {
+	defer func() {
+		job.idle.Stop()
+		job.cancel()
+		p.mu.Lock()
+		if p.jobs[id] == job {
+			delete(p.jobs, id)
+		}
+		p.mu.Unlock()
+	}()
+
+	// 目录最新图片只在任务开始时预热一次，避免每次更新都分析目录
+	latestDone := false
+	for {
+		select {
+		case <-job.ctx.Done():
+			return
+		case <-job.wake:
+		}
+
+		images, directoryID, err := p.collect(job.ctx, id)
+		if err != nil {
+			// 会话可能已被清理
+			return
+		}
+		format := p.format(job, images)
+		if !p.processImages(job, images, format) {
+			continue
+		}
+		if !latestDone {
+			latestDone = true
+			p.processLatestImage(job.ctx, directoryID, format)
+		}
+	}
+}
+
+// processImages 依次预热图片，队列变化或任务取消时返回 false
+func (p *Prewarmer) processImages(job *prewarmJob, images []*image.Image, format shared.ImageFormat) bool {
+	for _, img := range images {
+		for _, size := range p.opts.Sizes {
+			if len(job.wake) > 0 || job.ctx.Err() != nil {
+				// 按最新的队列重新开始
+				return false
+			}
+			p.process(job.ctx, img, size, format)
+		}
+	}
+	return job.ctx.Err() == nil
+}
+
+// collect 持锁读取会话当前图片及后续图片
+func (p *Prewarmer) collect(ctx context.Context, id scalar.ID) ([]*image.Image, scalar.ID, error) {
+	sess, release, err := p.sessionService.Acquire(ctx, id)
+	if err != nil {
+		return nil, scalar.ID{}, err
+	}
+	defer release()
+
+	var images []*image.Image
+	if current := sess.CurrentImage(); current != nil {
+		images = append(images, current)
+	}
+	images = append(images, sess.NextImages(p.opts.Count)...)
+	return images, sess.DirectoryID(), nil
+}
+
+func (p *Prewarmer) processLatestImage(ctx context.Context, directoryID scalar.ID, format shared.ImageFormat) {
+	relPath, err := directory.DecodeID(directoryID)
+	if err != nil {
+		p.logger.Error("failed to decode directory id", zap.Error(err))
+		return
+	}
+	stats, err := p.dirScanner.AnalyzeDirectory(ctx, relPath)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Warn("prewarm: failed to analyze directory", zap.String("path", relPath), zap.Error(err))
+		}
+		return
+	}
+	if latest := stats.LatestImage(); latest != nil {
+		p.process(ctx, latest, p.opts.ThumbnailSize, format)
+	}
+}
+
+func (p *Prewarmer) process(ctx context.Context, img *image.Image, size PreviewSize, format shared.ImageFormat) {
+	// 与 Image.url 一致，不放大图片
+	width := size.Width
+	if width >= img.Width() {
+		width = 0
+	}
+	if width == 0 && size.Quality == 0 {
+		// 原图无需处理
+		return
+	}
+
+	// 与 Image.url 一致，使用 sidecar 中设置的方向
+	ctx = appimage.ContextWithOrientation(ctx, img.XMPData().Orientation())
+
+	startTime := time.Now()
+	_, err := p.processor.Process(ctx, img.Path(), width, size.Quality, format)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Warn("prewarm: failed to process image",
+				zap.String("path", img.Path()),
+				zap.Int("width", width),
+				zap.Int("quality", size.Quality),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+	p.logger.Debug("prewarm: image processed",
+		zap.String("path", img.Path()),
+		zap.Int("width", width),
+		zap.Int("quality", size.Quality),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+}
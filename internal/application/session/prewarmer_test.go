@@ -0,0 +1,178 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"main/internal/domain/directory"
+	"main/internal/domain/image"
+	"main/internal/domain/session"
+	"main/internal/pubsub"
+	"main/internal/scalar"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeSessions struct {
+	sessions map[scalar.ID]*session.Session
+}
+
+func (f *fakeSessions) Acquire(ctx context.Context, id scalar.ID) (*session.Session, func(), error) {
+	if s, ok := f.sessions[id]; ok {
+		return s, func() {}, nil
+	}
+	return nil, nil, errors.New("not found")
+}
+
+// fakeScanner 只实现预热用到的 AnalyzeDirectory
+type fakeScanner struct {
+	directory.Scanner
+}
+
+func (f *fakeScanner) AnalyzeDirectory(ctx context.Context, relPath string) (*directory.DirectoryStats, error) {
+	return nil, errors.New("not implemented")
+}
+
+type processCall struct {
+	path    string
+	width   int
+	quality int
+	format  shared.ImageFormat
+}
+
+type fakeProcessor struct {
+	mu    sync.Mutex
+	calls []processCall
+}
+
+func (f *fakeProcessor) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, processCall{srcPath, width, quality, format})
+	return srcPath, nil
+}
+
+func (f *fakeProcessor) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeProcessor) Calls() []processCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]processCall(nil), f.calls...)
+}
+
+func (f *fakeProcessor) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// setupPrewarmer 创建包含 imageCount 张 1000 像素宽图片的会话及预热器
+func setupPrewarmer(t *testing.T, imageCount int, opts PrewarmOptions) (*Prewarmer, *fakeProcessor, *pubsub.InMemoryTopic[scalar.ID], scalar.ID) {
+	images := make([]*image.Image, imageCount)
+	for i := range images {
+		images[i] = image.NewImage(
+			scalar.ToID(fmt.Sprintf("img-%d", i)),
+			fmt.Sprintf("%d.jpg", i),
+			fmt.Sprintf("/test/%d.jpg", i),
+			1000,
+			time.Now(),
+			nil,
+			1000,
+			800,
+		)
+	}
+	id := scalar.ToID("s1")
+	sessions := &fakeSessions{sessions: map[scalar.ID]*session.Session{
+		id: session.NewSession(id, scalar.ToID("d1"), nil, 10, images),
+	}}
+
+	topic, cleanupTopic := pubsub.NewInMemoryTopic[scalar.ID]()
+	t.Cleanup(cleanupTopic)
+	processor := &fakeProcessor{}
+	p, cleanup := NewPrewarmer(sessions, &fakeScanner{}, processor, topic, opts, zap.NewNop())
+	t.Cleanup(cleanup)
+	return p, processor, topic, id
+}
+
+func TestPrewarmer_CountAndSizes(t *testing.T) {
+	p, processor, _, id := setupPrewarmer(t, 5, PrewarmOptions{
+		Count: 2,
+		// 不小于原图宽度且未指定质量时等同于原图，无需处理
+		Sizes:       []PreviewSize{{Width: 500}, {Width: 1000}, {Width: 2000, Quality: 80}},
+		Format:      shared.ImageFormatJPEG,
+		IdleTimeout: time.Minute,
+	})
+	p.notify(context.Background(), id)
+
+	want := []processCall{
+		{"/test/0.jpg", 500, 0, shared.ImageFormatJPEG},
+		{"/test/0.jpg", 0, 80, shared.ImageFormatJPEG},
+		{"/test/1.jpg", 500, 0, shared.ImageFormatJPEG},
+		{"/test/1.jpg", 0, 80, shared.ImageFormatJPEG},
+		{"/test/2.jpg", 500, 0, shared.ImageFormatJPEG},
+		{"/test/2.jpg", 0, 80, shared.ImageFormatJPEG},
+	}
+	require.Eventually(t, func() bool {
+		return len(processor.Calls()) >= len(want)
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, want, processor.Calls(), "Should prewarm current image and the next Count images")
+}
+
+func TestPrewarmer_IdleTimeout(t *testing.T) {
+	p, processor, topic, id := setupPrewarmer(t, 1, PrewarmOptions{
+		Sizes:       []PreviewSize{{Width: 500}},
+		Format:      shared.ImageFormatJPEG,
+		IdleTimeout: 50 * time.Millisecond,
+	})
+	p.notify(context.Background(), id)
+	require.Eventually(t, func() bool {
+		return len(processor.Calls()) == 1
+	}, time.Second, time.Millisecond)
+
+	// 会话空闲后停止任务
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return len(p.jobs) == 0
+	}, time.Second, time.Millisecond)
+
+	// 会话再次保存时重新开始
+	require.NoError(t, topic.Publish(context.Background(), id))
+	require.Eventually(t, func() bool {
+		return len(processor.Calls()) == 2
+	}, time.Second, time.Millisecond)
+}
+
+func TestPrewarmer_RequestedFormat(t *testing.T) {
+	p, processor, _, id := setupPrewarmer(t, 2, PrewarmOptions{
+		Count:       1,
+		Sizes:       []PreviewSize{{Width: 500}},
+		Format:      shared.ImageFormatAVIF,
+		IdleTimeout: time.Minute,
+	})
+	p.notify(context.Background(), id)
+	require.Eventually(t, func() bool {
+		return len(processor.Calls()) == 2
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, shared.ImageFormatAVIF, processor.Calls()[0].format, "Should use default format before any request")
+
+	// 客户端请求当前图片时协商出 WebP
+	p.RecordRequest("/test/0.jpg", shared.ImageFormatWebP)
+	processor.Reset()
+	p.notify(context.Background(), id)
+	require.Eventually(t, func() bool {
+		return len(processor.Calls()) == 2
+	}, time.Second, time.Millisecond)
+	for _, call := range processor.Calls() {
+		assert.Equal(t, shared.ImageFormatWebP, call.format)
+	}
+}
@@ -0,0 +1,141 @@
+package sidecar
+
+import (
+	"context"
+	"main/internal/apperror"
+	"main/internal/domain/directory"
+	"main/internal/domain/metadata"
+	"main/internal/scalar"
+	"main/internal/shared"
+	"main/internal/util"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Handler sidecar 修复应用层处理器
+type Handler struct {
+	recovery metadata.Recovery
+	rootDir  string
+	logger   *zap.Logger
+}
+
+// NewHandler 创建 sidecar 修复处理器
+func NewHandler(recovery metadata.Recovery, rootDir string, logger *zap.Logger) *Handler {
+	return &Handler{
+		recovery: recovery,
+		rootDir:  rootDir,
+		logger:   logger,
+	}
+}
+
+// SidecarIssues 递归列出目录下需要修复的 sidecar 文件
+func (h *Handler) SidecarIssues(ctx context.Context, directoryID scalar.ID) ([]*shared.SidecarIssueDTO, error) {
+	relPath, err := directory.DecodeID(directoryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := util.EnsurePathInRoot(h.rootDir, relPath); err != nil {
+		return nil, err
+	}
+
+	var result []*shared.SidecarIssueDTO
+	for issue, err := range h.recovery.Scan(ctx, filepath.Join(h.rootDir, relPath)) {
+		if err != nil {
+			return nil, err
+		}
+		dto, err := h.newDTO(issue)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, dto)
+	}
+	return result, nil
+}
+
+// ResolveSidecarIssue 修复 sidecar 问题，使用 SALVAGE 时返回提取到的评分
+func (h *Handler) ResolveSidecarIssue(
+	ctx context.Context,
+	id scalar.ID,
+	action shared.SidecarRecoveryAction,
+) (rating *int, err error) {
+	startTime := time.Now()
+
+	defer func() {
+		if err != nil {
+			h.logger.Error("resolve sidecar issue",
+				zap.Stringer("id", id),
+				zap.Stringer("action", action),
+				zap.Duration("duration", time.Since(startTime)),
+				zap.Error(err),
+			)
+		} else {
+			h.logger.Info("resolve sidecar issue",
+				zap.Stringer("id", id),
+				zap.Stringer("action", action),
+				zap.Duration("duration", time.Since(startTime)),
+			)
+		}
+	}()
+
+	relPath, err := metadata.DecodeSidecarIssueID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := util.EnsurePathInRoot(h.rootDir, relPath); err != nil {
+		return nil, err
+	}
+
+	issue, err := h.recovery.Inspect(filepath.Join(h.rootDir, filepath.FromSlash(relPath)))
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		// 问题已被其他途径修复
+		return nil, apperror.NewErrDocumentNotFound(id)
+	}
+
+	switch action {
+	case shared.SidecarRecoveryActionRestore:
+		err = h.recovery.Restore(issue)
+	case shared.SidecarRecoveryActionSalvage:
+		var v int
+		v, err = h.recovery.Salvage(issue)
+		if err == nil {
+			rating = &v
+		}
+	case shared.SidecarRecoveryActionDiscard:
+		err = h.recovery.Discard(issue)
+	default:
+		err = apperror.New("INVALID_ACTION", "invalid sidecar recovery action", "无效的 sidecar 修复操作")
+	}
+	return rating, err
+}
+
+func (h *Handler) newDTO(issue *metadata.SidecarIssue) (*shared.SidecarIssueDTO, error) {
+	relPath, err := filepath.Rel(h.rootDir, issue.Path())
+	if err != nil {
+		return nil, err
+	}
+	relImagePath, err := filepath.Rel(h.rootDir, issue.ImagePath())
+	if err != nil {
+		return nil, err
+	}
+	relPath = filepath.ToSlash(relPath)
+
+	var errMessage string
+	if issue.Err() != nil {
+		errMessage = issue.Err().Error()
+	}
+
+	return &shared.SidecarIssueDTO{
+		ID:                metadata.EncodeSidecarIssueID(relPath),
+		Kind:              issue.Kind(),
+		Path:              relPath,
+		ImagePath:         filepath.ToSlash(relImagePath),
+		ModTime:           issue.ModTime(),
+		Error:             errMessage,
+		SalvageableRating: issue.SalvageableRating(),
+	}, nil
+}
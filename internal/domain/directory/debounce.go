@@ -0,0 +1,196 @@
+package directory
+
+import (
+	"cmp"
+	"context"
+	"main/internal/shared"
+	"os"
+	"slices"
+	"time"
+)
+
+// pendingChange 是等待安静期结束的文件变更
+type pendingChange struct {
+	change   *FileChange
+	seq      uint64    // 首次加入的顺序，同时到期的变更按该顺序发布
+	deadline time.Time // 安静期结束的时间
+	size     int64     // 最近一次观察到的文件大小和修改时间，用于判断是否仍在写入
+	modTime  time.Time
+}
+
+// statChange 读取变更后路径上的文件信息，路径上已没有文件时返回 nil
+func statChange(c *FileChange) os.FileInfo {
+	if isRemoval(c) {
+		return nil
+	}
+	info, err := os.Stat(c.absPath)
+	if err != nil {
+		// 文件已不存在，随后的删除事件会与之合并
+		return nil
+	}
+	return info
+}
+
+// observe 记录文件当前的大小和修改时间，返回是否与上次不同
+func (p *pendingChange) observe(info os.FileInfo) bool {
+	if info == nil {
+		return false
+	}
+	changed := info.Size() != p.size || !info.ModTime().Equal(p.modTime)
+	p.size, p.modTime = info.Size(), info.ModTime()
+	return changed
+}
+
+// isRemoval 判断变更后路径上是否已没有文件
+func isRemoval(c *FileChange) bool {
+	return c.action == shared.FileActionRemove || (c.action == shared.FileActionRename && c.oldAbsPath == "")
+}
+
+// coalesce 将同一路径上先后发生的两个变更合并为一个，结果描述路径的最终状态
+//
+// 先创建后修改仍是创建；重命名到该路径后无论如何变化都保留旧路径，
+// 会话按新路径的最终状态迁移或删除图片
+func coalesce(prev, next *FileChange) *FileChange {
+	if prev.oldAbsPath != "" && next.oldAbsPath == "" {
+		return NewRenameFileChange(prev.oldAbsPath, next.absPath, next.occurredAt)
+	}
+	if next.action == shared.FileActionWrite && prev.action == shared.FileActionCreate {
+		return NewFileChange(next.absPath, shared.FileActionCreate, next.occurredAt)
+	}
+	return next
+}
+
+// enqueue 推迟发布文件变更，路径在安静期内没有新的变更且文件不再变化后才发布
+//
+// 生成图片的程序写入时会连续产生创建和多次修改，合并后下游只查找一次图片，
+// 也不会读取到写了一半的文件
+func (s *Service) enqueue(change *FileChange) {
+	// 读取文件信息可能很慢（如网络文件系统），不持有锁
+	info := statChange(change)
+
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	if change.oldAbsPath != "" {
+		if old, ok := s.pending[change.oldAbsPath]; ok {
+			// 旧路径的变更还没有发布，下游不知道旧路径，合并到新路径上
+			delete(s.pending, change.oldAbsPath)
+			switch {
+			case old.change.oldAbsPath != "":
+				change = NewRenameFileChange(old.change.oldAbsPath, change.absPath, change.occurredAt)
+			case old.change.action == shared.FileActionCreate:
+				change = NewFileChange(change.absPath, shared.FileActionCreate, change.occurredAt)
+			}
+		}
+	}
+
+	p, ok := s.pending[change.absPath]
+	if ok {
+		p.change = coalesce(p.change, change)
+	} else {
+		s.pendingSeq++
+		p = &pendingChange{change: change, seq: s.pendingSeq}
+		s.pending[change.absPath] = p
+	}
+	p.observe(info)
+	p.deadline = time.Now().Add(s.quietWindow)
+
+	select {
+	case s.pendingWake <- struct{}{}:
+	default:
+	}
+}
+
+// runDebounce 在安静期结束后按加入顺序发布变更，直到 ctx 取消
+//
+// 所有变更由同一个 goroutine 发布，下游收到的顺序与变更发生的顺序一致
+func (s *Service) runDebounce(ctx context.Context) {
+	timer := time.NewTimer(s.quietWindow)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.pendingWake:
+		case <-timer.C:
+		}
+
+		for _, change := range s.flushDue() {
+			if ctx.Err() != nil {
+				return
+			}
+			s.publish(ctx, change)
+		}
+
+		if next, ok := s.nextDeadline(); ok {
+			timer.Reset(next)
+		} else {
+			timer.Stop()
+		}
+	}
+}
+
+// flushDue 取出安静期已经结束且文件不再变化的变更，按加入顺序返回
+func (s *Service) flushDue() []*FileChange {
+	now := time.Now()
+	s.pendingMu.Lock()
+	var due []*pendingChange
+	for _, p := range s.pending {
+		if !p.deadline.After(now) {
+			due = append(due, p)
+		}
+	}
+	s.pendingMu.Unlock()
+	if len(due) == 0 {
+		return nil
+	}
+
+	infos := make([]os.FileInfo, len(due))
+	for i, p := range due {
+		infos[i] = statChange(p.change)
+	}
+
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	var ready []*pendingChange
+	for i, p := range due {
+		if s.pending[p.change.absPath] != p || p.deadline.After(now) {
+			// 已被新的变更合并、取代或推迟
+			continue
+		}
+		if p.observe(infos[i]) {
+			// 没有产生事件的写入（如网络文件系统），等待文件稳定
+			p.deadline = now.Add(s.quietWindow)
+			continue
+		}
+		delete(s.pending, p.change.absPath)
+		ready = append(ready, p)
+	}
+	slices.SortFunc(ready, func(a, b *pendingChange) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	changes := make([]*FileChange, len(ready))
+	for i, p := range ready {
+		changes[i] = p.change
+	}
+	return changes
+}
+
+// nextDeadline 返回距离最早的安静期结束还有多久，没有等待的变更时返回 false
+func (s *Service) nextDeadline() (time.Duration, bool) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	if len(s.pending) == 0 {
+		return 0, false
+	}
+	next := s.quietWindow
+	now := time.Now()
+	for _, p := range s.pending {
+		next = min(next, max(p.deadline.Sub(now), 0))
+	}
+	return next, true
+}
@@ -11,6 +11,7 @@ import (
 // 不暴露字段，只提供 getter 方法
 type FileChange struct {
 	absPath    string
+	oldAbsPath string // 重命名前的路径，只有配对的重命名有值
 	action     shared.FileAction
 	occurredAt time.Time
 }
@@ -24,9 +25,40 @@ func NewFileChange(absPath string, action shared.FileAction, occurredAt time.Tim
 	}
 }
 
+// NewRenameFileChange 创建重命名或移动的文件变更，absPath 为新路径
+//
+// 只用于能确定新旧路径的重命名，无法配对的旧路径按删除处理
+func NewRenameFileChange(oldAbsPath, absPath string, occurredAt time.Time) *FileChange {
+	return &FileChange{
+		absPath:    absPath,
+		oldAbsPath: oldAbsPath,
+		action:     shared.FileActionRename,
+		occurredAt: occurredAt,
+	}
+}
+
+func (c *FileChange) AbsPath() string {
+	return c.absPath
+}
+
+// OldAbsPath 返回重命名前的路径，不是重命名时为空
+func (c *FileChange) OldAbsPath() string {
+	return c.oldAbsPath
+}
+
+func (c *FileChange) Action() shared.FileAction {
+	return c.action
+}
+
+func (c *FileChange) OccurredAt() time.Time {
+	return c.occurredAt
+}
+
 // Watcher 文件系统监控器接口
 // FileChange 是专门为 Watcher 设计的领域对象
 type Watcher interface {
 	// Watch 监听指定目录的文件变更
+	//
+	// 监听目录内的重命名和移动应配对为 NewRenameFileChange，移出监听目录按删除处理
 	Watch(ctx context.Context, dir string) iter.Seq2[*FileChange, error]
 }
@@ -2,8 +2,12 @@ package directory
 
 import (
 	"context"
+	"main/internal/scalar"
 	"main/internal/shared"
 	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -21,24 +25,67 @@ type Service struct {
 	rootDir  string
 	logger   *zap.Logger
 	repo     Repository
+	formats  *shared.FormatRegistry
+
+	// quietWindow 是同一路径的变更合并的安静期，为 0 时立即发布
+	quietWindow time.Duration
+	pendingMu   sync.Mutex
+	pending     map[string]*pendingChange
+	pendingSeq  uint64
+	pendingWake chan struct{} // 有新的变更时唤醒发布任务
+}
+
+// ServiceOption 用于配置 Service
+type ServiceOption func(*Service)
+
+// WithQuietWindow 设置文件变更的安静期，路径在该时间内没有新的变更后才合并发布
+func WithQuietWindow(d time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.quietWindow = d
+	}
 }
 
 // NewService 创建目录服务
-func NewService(watcher Watcher, eventBus EventBus, rootDir string, repo Repository, logger *zap.Logger) (*Service, func()) {
+func NewService(
+	watcher Watcher,
+	eventBus EventBus,
+	rootDir string,
+	repo Repository,
+	formats *shared.FormatRegistry,
+	logger *zap.Logger,
+	options ...ServiceOption,
+) (*Service, func()) {
 	s := &Service{
-		watcher:  watcher,
-		eventBus: eventBus,
-		rootDir:  rootDir,
-		logger:   logger,
-		repo:     repo,
+		watcher:     watcher,
+		eventBus:    eventBus,
+		rootDir:     rootDir,
+		logger:      logger,
+		repo:        repo,
+		formats:     formats,
+		pending:     make(map[string]*pendingChange),
+		pendingWake: make(chan struct{}, 1),
+	}
+	for _, opt := range options {
+		opt(s)
 	}
 
 	// 启动后台监听
 	ctx, cancel := context.WithCancel(context.Background())
 	go s.watchAndTransform(ctx)
 
+	var wg sync.WaitGroup
+	if s.quietWindow > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runDebounce(ctx)
+		}()
+	}
+
 	cleanup := func() {
 		cancel()
+		// 等待发布任务退出，未到期的变更随服务一起丢弃
+		wg.Wait()
 	}
 
 	return s, cleanup
@@ -52,34 +99,97 @@ func (s *Service) watchAndTransform(ctx context.Context) {
 			continue
 		}
 
-		// 将绝对路径转换为相对路径
-		relPath, err := filepath.Rel(s.rootDir, fileChange.absPath)
-		if err != nil {
-			s.logger.Error("failed to get relative path",
-				zap.String("path", fileChange.absPath),
-				zap.String("root", s.rootDir),
-				zap.Error(err))
-			continue
+		for _, change := range s.splitSidecarRename(fileChange) {
+			change = s.resolveSidecarOwner(change)
+			if s.quietWindow > 0 {
+				s.enqueue(change)
+			} else {
+				s.publish(ctx, change)
+			}
 		}
+	}
+}
 
-		// 编码目录ID
-		dir, err := s.repo.GetByPath(ctx, filepath.Dir(relPath))
-		if err != nil {
-			s.logger.Error("failed to get directory by path",
-				zap.String("path", filepath.Dir(relPath)),
-				zap.Error(err))
-			continue
-		}
+// publish 将文件变更转换为应用层事件发布
+func (s *Service) publish(ctx context.Context, fileChange *FileChange) {
+	relPath, dirID, ok := s.locate(ctx, fileChange.absPath)
+	if !ok {
+		return
+	}
 
-		// 构建应用层事件
-		event := &shared.FileChangedEvent{
-			DirectoryID: dir.ID(),
-			RelPath:     relPath,
-			Action:      fileChange.action,
-			OccurredAt:  fileChange.occurredAt,
+	// 构建应用层事件
+	event := &shared.FileChangedEvent{
+		DirectoryID: dirID,
+		RelPath:     relPath,
+		Action:      fileChange.action,
+		OccurredAt:  fileChange.occurredAt,
+	}
+	if fileChange.oldAbsPath != "" {
+		event.OldRelPath, event.OldDirectoryID, ok = s.locate(ctx, fileChange.oldAbsPath)
+		if !ok {
+			return
 		}
+	}
+
+	// 发布事件
+	s.eventBus.PublishFileChanged(ctx, event)
+}
 
-		// 发布事件
-		s.eventBus.PublishFileChanged(ctx, event)
+// locate 返回文件相对于根目录的路径和所在目录的 ID
+func (s *Service) locate(ctx context.Context, absPath string) (string, scalar.ID, bool) {
+	// 将绝对路径转换为相对路径
+	relPath, err := filepath.Rel(s.rootDir, absPath)
+	if err != nil {
+		s.logger.Error("failed to get relative path",
+			zap.String("path", absPath),
+			zap.String("root", s.rootDir),
+			zap.Error(err))
+		return "", scalar.ID{}, false
+	}
+
+	// 编码目录ID
+	dir, err := s.repo.GetByPath(ctx, filepath.Dir(relPath))
+	if err != nil {
+		s.logger.Error("failed to get directory by path",
+			zap.String("path", filepath.Dir(relPath)),
+			zap.Error(err))
+		return "", scalar.ID{}, false
+	}
+	return relPath, dir.ID(), true
+}
+
+// splitSidecarRename 将涉及 sidecar 的重命名拆分为旧路径的删除和新路径的创建
+//
+// sidecar 重命名后新旧两个路径所属的图片元数据都发生了变化，需要分别转换为图片更新
+func (s *Service) splitSidecarRename(fileChange *FileChange) []*FileChange {
+	if fileChange.oldAbsPath == "" ||
+		(!strings.EqualFold(filepath.Ext(fileChange.oldAbsPath), sidecarExt) &&
+			!strings.EqualFold(filepath.Ext(fileChange.absPath), sidecarExt)) {
+		return []*FileChange{fileChange}
+	}
+	return []*FileChange{
+		NewFileChange(fileChange.oldAbsPath, shared.FileActionRemove, fileChange.occurredAt),
+		NewFileChange(fileChange.absPath, shared.FileActionCreate, fileChange.occurredAt),
+	}
+}
+
+// sidecarExt 是 XMP sidecar 文件的扩展名
+const sidecarExt = ".xmp"
+
+// resolveSidecarOwner 将 sidecar 的变更转换为所属图片的更新
+//
+// 外部工具（如 Lightroom）修改评分时只会修改 sidecar，
+// 转换后会话和目录统计缓存才能感知到评分变化。
+// 不属于图片的文件原样返回。
+func (s *Service) resolveSidecarOwner(fileChange *FileChange) *FileChange {
+	ext := filepath.Ext(fileChange.absPath)
+	if !strings.EqualFold(ext, sidecarExt) {
+		return fileChange
+	}
+	ownerPath := strings.TrimSuffix(fileChange.absPath, ext)
+	if !s.formats.IsSupported(ownerPath) {
+		return fileChange
 	}
+	// sidecar 的创建、删除都只影响图片的元数据，统一视为图片更新
+	return NewFileChange(ownerPath, shared.FileActionWrite, fileChange.occurredAt)
 }
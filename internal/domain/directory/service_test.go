@@ -0,0 +1,280 @@
+package directory
+
+import (
+	"context"
+	"iter"
+	"main/internal/scalar"
+	"main/internal/shared"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// #region Fakes
+
+type fakeWatcher struct {
+	changes []*FileChange
+}
+
+func (w *fakeWatcher) Watch(ctx context.Context, dir string) iter.Seq2[*FileChange, error] {
+	return func(yield func(*FileChange, error) bool) {
+		for _, c := range w.changes {
+			if !yield(c, nil) {
+				return
+			}
+		}
+	}
+}
+
+type fakeEventBus struct {
+	events chan *shared.FileChangedEvent
+}
+
+func (b *fakeEventBus) PublishFileChanged(ctx context.Context, event *shared.FileChangedEvent) {
+	b.events <- event
+}
+
+type fakeRepository struct{}
+
+func (r *fakeRepository) Get(ctx context.Context, id scalar.ID) (*Directory, error) {
+	path, err := DecodeID(id)
+	if err != nil {
+		return nil, err
+	}
+	return FromRepository(id, path), nil
+}
+
+func (r *fakeRepository) GetByPath(ctx context.Context, path string) (*Directory, error) {
+	return FromRepository(EncodeID(path), path), nil
+}
+
+// #endregion
+
+func collectEvents(t *testing.T, rootDir string, changes ...*FileChange) []*shared.FileChangedEvent {
+	return collectNEvents(t, rootDir, len(changes), changes...)
+}
+
+// collectNEvents 等待 n 个事件，用于一个变更产生多个事件的情况
+func collectNEvents(t *testing.T, rootDir string, n int, changes ...*FileChange) []*shared.FileChangedEvent {
+	bus := &fakeEventBus{events: make(chan *shared.FileChangedEvent, n)}
+	_, cleanup := NewService(&fakeWatcher{changes: changes}, bus, rootDir, &fakeRepository{}, shared.NewDefaultFormatRegistry(), zap.NewNop())
+	defer cleanup()
+
+	var result []*shared.FileChangedEvent
+	for range n {
+		select {
+		case e := <-bus.events:
+			result = append(result, e)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timeout waiting for file changed event")
+		}
+	}
+	return result
+}
+
+func TestService_SidecarChange_ShouldBeReemittedAsImageUpdate(t *testing.T) {
+	rootDir := t.TempDir()
+	now := time.Now()
+
+	events := collectEvents(t, rootDir,
+		NewFileChange(filepath.Join(rootDir, "a", "1.png.xmp"), shared.FileActionCreate, now),
+		NewFileChange(filepath.Join(rootDir, "a", "2.JPG.XMP"), shared.FileActionRemove, now),
+	)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, filepath.Join("a", "1.png"), events[0].RelPath)
+	assert.Equal(t, shared.FileActionWrite, events[0].Action)
+	assert.Equal(t, EncodeID("a"), events[0].DirectoryID)
+	assert.Equal(t, filepath.Join("a", "2.JPG"), events[1].RelPath)
+	assert.Equal(t, shared.FileActionWrite, events[1].Action)
+}
+
+func TestService_NonImageSidecarChange_ShouldPassThrough(t *testing.T) {
+	rootDir := t.TempDir()
+	now := time.Now()
+
+	events := collectEvents(t, rootDir,
+		NewFileChange(filepath.Join(rootDir, "notes.xmp"), shared.FileActionCreate, now),
+		NewFileChange(filepath.Join(rootDir, "1.png"), shared.FileActionRemove, now),
+	)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, "notes.xmp", events[0].RelPath)
+	assert.Equal(t, shared.FileActionCreate, events[0].Action)
+	assert.Equal(t, "1.png", events[1].RelPath)
+	assert.Equal(t, shared.FileActionRemove, events[1].Action)
+}
+
+func TestService_Rename_ShouldCarryOldPath(t *testing.T) {
+	rootDir := t.TempDir()
+	now := time.Now()
+
+	events := collectEvents(t, rootDir,
+		NewRenameFileChange(filepath.Join(rootDir, "a", "1.png"), filepath.Join(rootDir, "b", "2.png"), now),
+		NewFileChange(filepath.Join(rootDir, "3.png"), shared.FileActionRename, now),
+	)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, filepath.Join("b", "2.png"), events[0].RelPath)
+	assert.Equal(t, EncodeID("b"), events[0].DirectoryID)
+	assert.Equal(t, filepath.Join("a", "1.png"), events[0].OldRelPath)
+	assert.Equal(t, EncodeID("a"), events[0].OldDirectoryID)
+	assert.Equal(t, shared.FileActionRename, events[0].Action)
+
+	// 没有配对的重命名只有旧路径
+	assert.Equal(t, "3.png", events[1].RelPath)
+	assert.Empty(t, events[1].OldRelPath)
+}
+
+func TestService_SidecarRename_ShouldUpdateBothImages(t *testing.T) {
+	rootDir := t.TempDir()
+	now := time.Now()
+
+	events := collectNEvents(t, rootDir, 2,
+		NewRenameFileChange(filepath.Join(rootDir, "1.png.xmp"), filepath.Join(rootDir, "2.png.xmp"), now),
+	)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, "1.png", events[0].RelPath)
+	assert.Equal(t, shared.FileActionWrite, events[0].Action)
+	assert.Empty(t, events[0].OldRelPath)
+	assert.Equal(t, "2.png", events[1].RelPath)
+	assert.Equal(t, shared.FileActionWrite, events[1].Action)
+}
+
+// collectDebounced 收集合并后的事件，并确认没有多余的事件
+func collectDebounced(t *testing.T, rootDir string, n int, changes ...*FileChange) []*shared.FileChangedEvent {
+	const window = 20 * time.Millisecond
+	bus := &fakeEventBus{events: make(chan *shared.FileChangedEvent, len(changes))}
+	_, cleanup := NewService(&fakeWatcher{changes: changes}, bus, rootDir, &fakeRepository{}, shared.NewDefaultFormatRegistry(), zap.NewNop(), WithQuietWindow(window))
+	defer cleanup()
+
+	var result []*shared.FileChangedEvent
+	for range n {
+		select {
+		case e := <-bus.events:
+			result = append(result, e)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timeout waiting for file changed event")
+		}
+	}
+	select {
+	case e := <-bus.events:
+		assert.Failf(t, "unexpected event", "%s %s", e.Action, e.RelPath)
+	case <-time.After(5 * window):
+	}
+	return result
+}
+
+func TestService_Debounce_ShouldCoalesceBurst(t *testing.T) {
+	rootDir := t.TempDir()
+	path := filepath.Join(rootDir, "1.png")
+	require.NoError(t, os.WriteFile(path, []byte("png"), 0644))
+	now := time.Now()
+
+	events := collectDebounced(t, rootDir, 2,
+		NewFileChange(path, shared.FileActionCreate, now),
+		NewFileChange(path, shared.FileActionWrite, now),
+		NewFileChange(filepath.Join(rootDir, "1.png.xmp"), shared.FileActionCreate, now),
+		NewFileChange(path, shared.FileActionWrite, now),
+		NewFileChange(filepath.Join(rootDir, "2.png"), shared.FileActionCreate, now),
+		NewFileChange(filepath.Join(rootDir, "2.png"), shared.FileActionRemove, now),
+	)
+
+	byPath := make(map[string]shared.FileAction)
+	for _, e := range events {
+		byPath[e.RelPath] = e.Action
+	}
+	assert.Equal(t, map[string]shared.FileAction{
+		"1.png": shared.FileActionCreate,
+		"2.png": shared.FileActionRemove,
+	}, byPath)
+}
+
+func TestService_Debounce_ShouldCoalesceRename(t *testing.T) {
+	rootDir := t.TempDir()
+	now := time.Now()
+
+	events := collectDebounced(t, rootDir, 2,
+		// 先写入临时文件再重命名，下游只看到最终的文件
+		NewFileChange(filepath.Join(rootDir, "1.png.part"), shared.FileActionCreate, now),
+		NewFileChange(filepath.Join(rootDir, "1.png.part"), shared.FileActionWrite, now),
+		NewRenameFileChange(filepath.Join(rootDir, "1.png.part"), filepath.Join(rootDir, "1.png"), now),
+		// 已有文件重命名后修改，保留旧路径
+		NewRenameFileChange(filepath.Join(rootDir, "2.png"), filepath.Join(rootDir, "3.png"), now),
+		NewFileChange(filepath.Join(rootDir, "3.png"), shared.FileActionWrite, now),
+	)
+
+	require.Len(t, events, 2)
+	byPath := make(map[string]*shared.FileChangedEvent)
+	for _, e := range events {
+		byPath[e.RelPath] = e
+	}
+	require.Contains(t, byPath, "1.png")
+	assert.Equal(t, shared.FileActionCreate, byPath["1.png"].Action)
+	assert.Empty(t, byPath["1.png"].OldRelPath)
+	require.Contains(t, byPath, "3.png")
+	assert.Equal(t, shared.FileActionRename, byPath["3.png"].Action)
+	assert.Equal(t, "2.png", byPath["3.png"].OldRelPath)
+}
+
+func TestService_Debounce_ShouldPublishInOrder(t *testing.T) {
+	rootDir := t.TempDir()
+	now := time.Now()
+
+	var changes []*FileChange
+	var want []string
+	for i := range 20 {
+		name := strconv.Itoa(i) + ".png"
+		changes = append(changes, NewFileChange(filepath.Join(rootDir, name), shared.FileActionRemove, now))
+		want = append(want, name)
+	}
+
+	events := collectDebounced(t, rootDir, len(changes), changes...)
+
+	var got []string
+	for _, e := range events {
+		got = append(got, e.RelPath)
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestService_Debounce_ShouldDropPendingOnCleanup(t *testing.T) {
+	const window = 20 * time.Millisecond
+	rootDir := t.TempDir()
+	bus := &fakeEventBus{events: make(chan *shared.FileChangedEvent, 1)}
+	changes := []*FileChange{NewFileChange(filepath.Join(rootDir, "1.png"), shared.FileActionRemove, time.Now())}
+	_, cleanup := NewService(&fakeWatcher{changes: changes}, bus, rootDir, &fakeRepository{}, shared.NewDefaultFormatRegistry(), zap.NewNop(), WithQuietWindow(window))
+
+	time.Sleep(window / 4)
+	cleanup()
+
+	select {
+	case e := <-bus.events:
+		assert.Failf(t, "unexpected event", "%s %s", e.Action, e.RelPath)
+	case <-time.After(5 * window):
+	}
+}
+
+func TestPendingChange_ShouldWaitForStableFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "1.png")
+	require.NoError(t, os.WriteFile(path, []byte("p"), 0644))
+
+	p := &pendingChange{change: NewFileChange(path, shared.FileActionCreate, time.Now())}
+	assert.True(t, p.observe(statChange(p.change)))
+	assert.False(t, p.observe(statChange(p.change)), "unchanged file is stable")
+
+	// 没有产生事件的继续写入
+	require.NoError(t, os.WriteFile(path, []byte("png"), 0644))
+	assert.True(t, p.observe(statChange(p.change)))
+
+	// 删除后不再观察
+	require.NoError(t, os.Remove(path))
+	assert.False(t, p.observe(statChange(p.change)))
+}
@@ -2,28 +2,87 @@ package image
 
 import (
 	"context"
+	"fmt"
 	"main/internal/domain/metadata"
 	"main/internal/shared"
 	"main/internal/util"
 	"os"
 	"path/filepath"
-	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 )
 
 type Processor interface {
 	Meta(ctx context.Context, path string) (*shared.ImageMeta, error)
 }
 
+// Fingerprinter 计算文件内容的指纹，用于不依赖路径和修改时间的图片标识
+type Fingerprinter interface {
+	Fingerprint(path string, size int64, modTime time.Time) (string, error)
+}
+
 type Factory struct {
-	xmpRepo   metadata.Repository
-	processor Processor
+	xmpRepo       metadata.Repository
+	processor     Processor
+	formats       *shared.FormatRegistry
+	index         Index
+	fingerprinter Fingerprinter
+	logger        *zap.Logger
+	probes        singleflight.Group
+
+	// failedProbes 记录各路径最近一次失败的探测，文件变化前不重复探测
+	failedMu     sync.Mutex
+	failedProbes map[string]failedProbe
+}
+
+type failedProbe struct {
+	size    int64
+	modTime time.Time
+	err     error
+}
+
+// FactoryOption 用于设置 Factory 的可选依赖
+type FactoryOption func(*Factory)
+
+// WithIndex 使用索引缓存 sidecar 读取和尺寸探测的结果，只有变化的文件需要重新读取
+func WithIndex(index Index) FactoryOption {
+	return func(f *Factory) {
+		f.index = index
+	}
+}
+
+// WithFingerprinter 使用内容指纹和路径作为图片 ID
+//
+// 只有修改时间变化时 ID 不变；文件重命名或移动时，会话根据指纹将删除和创建关联为移动，决定随文件迁移。
+// 内容完全相同的多个文件是不同的图片
+func WithFingerprinter(fingerprinter Fingerprinter) FactoryOption {
+	return func(f *Factory) {
+		f.fingerprinter = fingerprinter
+	}
+}
+
+// WithLogger 记录尺寸探测失败等不影响扫描的错误
+func WithLogger(logger *zap.Logger) FactoryOption {
+	return func(f *Factory) {
+		f.logger = logger
+	}
 }
 
-func NewFactory(xmpRepo metadata.Repository, processor Processor) *Factory {
-	return &Factory{
-		xmpRepo:   xmpRepo,
-		processor: processor,
+func NewFactory(xmpRepo metadata.Repository, processor Processor, formats *shared.FormatRegistry, options ...FactoryOption) *Factory {
+	f := &Factory{
+		xmpRepo:      xmpRepo,
+		processor:    processor,
+		formats:      formats,
+		logger:       zap.NewNop(),
+		failedProbes: make(map[string]failedProbe),
+	}
+	for _, opt := range options {
+		opt(f)
 	}
+	return f
 }
 
 func (f *Factory) Create(ctx context.Context, relPath string, rootDir string) (*Image, error) {
@@ -58,32 +117,149 @@ func (f *Factory) CreateFromInfo(ctx context.Context, info os.FileInfo, absPath
 		return nil, nil
 	}
 
-	var xmpData *metadata.XMPData
-	xmpData, err := f.xmpRepo.Read(absPath)
+	var cached *IndexEntry
+	if f.index != nil {
+		cached = f.index.Get(absPath)
+	}
+	entry := &IndexEntry{Size: info.Size(), ModTime: info.ModTime()}
+
+	var options []ImageOption
+	xmpData, err := f.readXMP(absPath, cached)
 	if err != nil {
-		return nil, err
+		// sidecar 损坏时仍然返回图片并标记错误，避免单个文件导致整个目录无法扫描
+		options = append(options, WithXMPError(err))
 	}
+	entry.XMPData = xmpData
 
-	width, height := 0, 0
-	if f.processor != nil {
-		meta, err := f.processor.Meta(ctx, absPath)
-		if err == nil {
-			width, height = meta.Width, meta.Height
+	id := newID(absPath, info.ModTime())
+	if f.fingerprinter != nil {
+		if cached.fileValid(info.Size(), info.ModTime()) && cached.Fingerprint != "" {
+			entry.Fingerprint = cached.Fingerprint
+		} else if fingerprint, err := f.fingerprinter.Fingerprint(absPath, info.Size(), info.ModTime()); err == nil {
+			entry.Fingerprint = fingerprint
 		}
+		// 读取失败时使用路径标识，这种文件通常也无法预览
+		if entry.Fingerprint != "" {
+			id = newContentID(entry.Fingerprint, absPath)
+			options = append(options, WithFingerprint(entry.Fingerprint))
+		}
+	}
+
+	// 占位图由其他组件按需生成，文件未变化时保留
+	if cached.fileValid(info.Size(), info.ModTime()) {
+		entry.Placeholder = cached.Placeholder
+		entry.PlaceholderOrientation = cached.PlaceholderOrientation
+	}
+
+	// 尺寸探测较慢，索引中没有有效结果时延迟到首次访问，扫描只读取文件信息和 sidecar
+	if cached.metaValid(info.Size(), info.ModTime()) {
+		entry.Meta = cached.Meta
+		options = append(options, WithMeta(entry.Meta))
+	} else if f.processor != nil {
+		options = append(options, WithMetaLoader(f.metaLoader(ctx, absPath, *entry)))
 	}
 
-	return NewImageFromPath(
+	if f.index != nil && (cached == nil ||
+		cached.Meta != entry.Meta ||
+		cached.XMPData != entry.XMPData ||
+		cached.Fingerprint != entry.Fingerprint) {
+		f.index.Put(absPath, entry)
+	}
+
+	if f.formats.IsVideo(info.Name()) {
+		options = append(options, WithVideo())
+	}
+
+	return NewImage(
+		id,
 		info.Name(),
 		absPath,
 		info.Size(),
 		info.ModTime(),
 		xmpData,
-		width,
-		height,
+		0,
+		0,
+		options...,
 	), nil
 }
 
+// metaLoader 返回延迟探测函数，同一文件的并发探测只执行一次，成功的结果写入索引
+//
+// 失败的结果记录到文件变化为止，期间的访问直接返回记录的错误，不重复探测和记录日志
+func (f *Factory) metaLoader(ctx context.Context, absPath string, entry IndexEntry) func() (*shared.ImageMeta, error) {
+	// 访问时扫描可能已经结束，探测不随扫描取消
+	ctx = context.WithoutCancel(ctx)
+	key := fmt.Sprintf("%s|%d|%d", absPath, entry.Size, entry.ModTime.UnixNano())
+	return func() (*shared.ImageMeta, error) {
+		if err := f.probeFailure(absPath, entry); err != nil {
+			return nil, err
+		}
+		v, err, _ := f.probes.Do(key, func() (any, error) {
+			// 其他扫描创建的图片可能已经探测完成
+			if f.index != nil {
+				if cached := f.index.Get(absPath); cached.metaValid(entry.Size, entry.ModTime) {
+					return cached.Meta, nil
+				}
+			}
+			meta, err := f.processor.Meta(ctx, absPath)
+			if err != nil {
+				f.logger.Warn("failed to probe image meta",
+					zap.String("path", absPath),
+					zap.Error(err),
+				)
+				f.setProbeFailure(absPath, entry, err)
+				return nil, err
+			}
+			f.setProbeFailure(absPath, entry, nil)
+			if f.index != nil {
+				// 基于最新的记录更新，保留扫描后写入的占位图等信息
+				next := entry
+				if cached := f.index.Get(absPath); cached.fileValid(entry.Size, entry.ModTime) {
+					next = *cached
+				}
+				next.Meta = meta
+				f.index.Put(absPath, &next)
+			}
+			return meta, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return v.(*shared.ImageMeta), nil
+	}
+}
+
+// probeFailure 返回文件未变化时记录的探测错误
+func (f *Factory) probeFailure(absPath string, entry IndexEntry) error {
+	f.failedMu.Lock()
+	defer f.failedMu.Unlock()
+	if failed, ok := f.failedProbes[absPath]; ok && failed.size == entry.Size && failed.modTime.Equal(entry.ModTime) {
+		return failed.err
+	}
+	return nil
+}
+
+// setProbeFailure 记录探测错误，err 为 nil 时清除记录，每个路径只保留最近一次的结果
+func (f *Factory) setProbeFailure(absPath string, entry IndexEntry, err error) {
+	f.failedMu.Lock()
+	defer f.failedMu.Unlock()
+	if err == nil {
+		delete(f.failedProbes, absPath)
+		return
+	}
+	f.failedProbes[absPath] = failedProbe{size: entry.Size, modTime: entry.ModTime, err: err}
+}
+
+// readXMP 读取 sidecar，修订版本未变化时使用索引中的结果
+func (f *Factory) readXMP(absPath string, cached *IndexEntry) (*metadata.XMPData, error) {
+	if cached != nil {
+		if revision, err := f.xmpRepo.Revision(absPath); err == nil && cached.xmpValid(revision) {
+			return cached.XMPData, nil
+		}
+	}
+	return f.xmpRepo.Read(absPath)
+}
+
 func (f *Factory) isSupportedImage(filename string) bool {
-	ext := strings.ToLower(filepath.Ext(filename))
-	return ext == ".jpg" || ext == ".jpeg" || ext == ".png" || ext == ".webp" || ext == ".avif"
+	return f.formats.IsSupported(filename)
 }
@@ -5,46 +5,90 @@ import (
 	"encoding/hex"
 	"main/internal/domain/metadata"
 	"main/internal/scalar"
+	"main/internal/shared"
 	"time"
 )
 
 type Image struct {
-	id       scalar.ID
-	filename string
-	path     string
-	size     int64
-	modTime  time.Time
-	xmpData  *metadata.XMPData
-	width    int
-	height   int
-}
-
-func NewImage(id scalar.ID, filename, path string, size int64, modTime time.Time, xmpData *metadata.XMPData, width, height int) *Image {
-	return &Image{
-		id:       id,
-		filename: filename,
-		path:     path,
-		size:     size,
-		modTime:  modTime,
-		xmpData:  xmpData,
-		width:    width,
-		height:   height,
+	id        scalar.ID
+	filename  string
+	path      string
+	size      int64
+	modTime   time.Time
+	xmpData   *metadata.XMPData
+	xmpError  error
+	mediaType shared.MediaType
+
+	fingerprint string // 内容指纹，未使用内容标识时为空
+
+	// meta 是原图的尺寸、方向和时长，可能在首次访问时才探测，副本之间共享
+	meta *lazyMeta
+}
+
+// ImageOption 用于设置 Image 的可选字段
+type ImageOption func(*Image)
+
+// WithXMPError 记录读取 XMP 时的错误（如 sidecar 损坏），此时 XMP 数据视为不存在
+func WithXMPError(err error) ImageOption {
+	return func(i *Image) {
+		i.xmpError = err
+	}
+}
+
+// WithFingerprint 记录内容指纹，用于将文件的删除和创建关联为移动
+func WithFingerprint(fingerprint string) ImageOption {
+	return func(i *Image) {
+		i.fingerprint = fingerprint
 	}
 }
 
-func NewImageFromPath(filename, path string, size int64, modTime time.Time, xmpData *metadata.XMPData, width, height int) *Image {
-	return &Image{
-		id:       newID(path, modTime),
-		filename: filename,
-		path:     path,
-		size:     size,
-		modTime:  modTime,
-		xmpData:  xmpData,
-		width:    width,
-		height:   height,
+// WithVideo 标记为视频
+func WithVideo() ImageOption {
+	return func(i *Image) {
+		i.mediaType = shared.MediaTypeVideo
 	}
 }
 
+// WithMeta 使用已探测的元数据，忽略 NewImage 的 width、height 参数
+func WithMeta(meta *shared.ImageMeta) ImageOption {
+	return func(i *Image) {
+		i.meta = resolvedMeta(meta)
+	}
+}
+
+// WithMetaLoader 延迟探测元数据，load 在首次访问尺寸、方向或时长时调用，失败时在下次访问时再次调用
+func WithMetaLoader(load func() (*shared.ImageMeta, error)) ImageOption {
+	return func(i *Image) {
+		i.meta = &lazyMeta{load: load}
+	}
+}
+
+// NewImage 创建图片，width、height 为按生效的方向旋转后的尺寸
+func NewImage(id scalar.ID, filename, path string, size int64, modTime time.Time, xmpData *metadata.XMPData, width, height int, options ...ImageOption) *Image {
+	img := &Image{
+		id:        id,
+		filename:  filename,
+		path:      path,
+		size:      size,
+		modTime:   modTime,
+		xmpData:   xmpData,
+		mediaType: shared.MediaTypeImage,
+	}
+	for _, opt := range options {
+		opt(img)
+	}
+	if img.meta == nil {
+		// 未指定原图方向，转换为不旋转时的尺寸
+		width, height = displaySize(width, height, xmpData.Orientation(), 1)
+		img.meta = resolvedMeta(&shared.ImageMeta{Width: width, Height: height})
+	}
+	return img
+}
+
+func NewImageFromPath(filename, path string, size int64, modTime time.Time, xmpData *metadata.XMPData, width, height int, options ...ImageOption) *Image {
+	return NewImage(newID(path, modTime), filename, path, size, modTime, xmpData, width, height, options...)
+}
+
 func (i *Image) ID() scalar.ID {
 	return i.id
 }
@@ -72,6 +116,16 @@ func (i *Image) Rating() int {
 	return 0
 }
 
+// Note 返回 XMP 中记录的备注
+func (i *Image) Note() string {
+	return i.xmpData.Note()
+}
+
+// History 返回 XMP 中记录的编辑历史
+func (i *Image) History() []metadata.HistoryEvent {
+	return i.xmpData.History()
+}
+
 func (i *Image) XMPData() *metadata.XMPData {
 	return i.xmpData
 }
@@ -80,12 +134,91 @@ func (i *Image) XMPExists() bool {
 	return i.xmpData != nil
 }
 
+// XMPError 返回读取 XMP 时的错误，nil 表示正常
+func (i *Image) XMPError() error {
+	return i.xmpError
+}
+
+// XMPRevision 返回读取时 sidecar 的修订版本，写入时用于检测外部修改
+//
+// sidecar 无法解析时 XMP 数据视为不存在，但修订版本仍然有效
+func (i *Image) XMPRevision() string {
+	if i.xmpData != nil {
+		return i.xmpData.Revision()
+	}
+	return metadata.UnparseableRevision(i.xmpError)
+}
+
+// Width 返回按生效的方向旋转后的宽度，尚未探测时阻塞直到探测完成，探测失败时为 0
 func (i *Image) Width() int {
-	return i.width
+	width, _ := i.displaySize()
+	return width
 }
 
+// Height 返回按生效的方向旋转后的高度，与 Width 相同可能触发探测
 func (i *Image) Height() int {
-	return i.height
+	_, height := i.displaySize()
+	return height
+}
+
+func (i *Image) displaySize() (int, int) {
+	// 只探测一次，探测失败时不在同一次访问中重试
+	meta := i.meta.get()
+	return displaySize(meta.Width, meta.Height, meta.Orientation, i.orientation(meta))
+}
+
+// Orientation 返回生效的方向（1-8），sidecar 中设置的方向优先于原图中的方向
+//
+// sidecar 中没有设置方向时需要原图中的方向，可能触发探测
+func (i *Image) Orientation() int {
+	if o := i.xmpData.Orientation(); o != 0 {
+		return o
+	}
+	return i.orientation(i.meta.get())
+}
+
+// orientation 使用已探测的元数据返回生效的方向
+func (i *Image) orientation(meta *shared.ImageMeta) int {
+	if o := i.xmpData.Orientation(); o != 0 {
+		return o
+	}
+	if o := meta.Orientation; o != 0 {
+		return o
+	}
+	return 1
+}
+
+// MetaResolved 判断尺寸等元数据是否已经探测
+func (i *Image) MetaResolved() bool {
+	return i.meta.resolved()
+}
+
+// WithXMPData 返回使用新 XMP 数据的副本，方向改变时宽高随之交换
+func (i *Image) WithXMPData(xmpData *metadata.XMPData) *Image {
+	img := *i
+	img.xmpData = xmpData
+	img.xmpError = nil
+	return &img
+}
+
+// Fingerprint 返回内容指纹，未使用内容标识时为空
+func (i *Image) Fingerprint() string {
+	return i.fingerprint
+}
+
+// MediaType 返回媒体类型
+func (i *Image) MediaType() shared.MediaType {
+	return i.mediaType
+}
+
+// IsVideo 判断是否为视频
+func (i *Image) IsVideo() bool {
+	return i.mediaType == shared.MediaTypeVideo
+}
+
+// Duration 返回视频时长，图片为 0
+func (i *Image) Duration() time.Duration {
+	return i.meta.get().Duration
 }
 
 func newID(path string, modTime time.Time) scalar.ID {
@@ -94,3 +227,13 @@ func newID(path string, modTime time.Time) scalar.ID {
 	hash.Write([]byte(modTime.String()))
 	return scalar.ToID(hex.EncodeToString(hash.Sum(nil))[:16])
 }
+
+// newContentID 根据内容指纹和路径生成 ID，与修改时间无关
+//
+// 包含路径以区分内容相同的副本，重命名或移动由会话根据指纹迁移操作记录
+func newContentID(fingerprint, path string) scalar.ID {
+	hash := sha256.New()
+	hash.Write([]byte(fingerprint))
+	hash.Write([]byte(path))
+	return scalar.ToID(hex.EncodeToString(hash.Sum(nil))[:16])
+}
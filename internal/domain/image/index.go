@@ -0,0 +1,57 @@
+package image
+
+import (
+	"main/internal/domain/metadata"
+	"main/internal/shared"
+	"time"
+)
+
+// IndexEntry 是索引中记录的图片元数据
+//
+// 图片和 sidecar 分别校验：图片大小和修改时间未变化时复用 Meta，
+// sidecar 修订版本未变化时复用 XMPData
+type IndexEntry struct {
+	Size    int64
+	ModTime time.Time
+	Meta    *shared.ImageMeta // 尚未探测或探测失败时为 nil
+	XMPData *metadata.XMPData // 没有 sidecar 或读取失败时为 nil
+
+	Fingerprint string // 内容指纹，未使用内容标识时为空
+
+	Placeholder            string // Base64 编码的 ThumbHash，尚未生成时为空
+	PlaceholderOrientation int    // 生成占位图时 sidecar 中设置的方向，0 表示未设置
+}
+
+// fileValid 判断图片文件是否未变化，此时 Meta、Fingerprint 和 Placeholder 仍然有效
+func (e *IndexEntry) fileValid(size int64, modTime time.Time) bool {
+	return e != nil && e.Size == size && e.ModTime.Equal(modTime)
+}
+
+// PlaceholderFor 返回图片文件未变化且方向相同时记录的占位图
+func (e *IndexEntry) PlaceholderFor(size int64, modTime time.Time, orientation int) (string, bool) {
+	if !e.fileValid(size, modTime) || e.Placeholder == "" || e.PlaceholderOrientation != orientation {
+		return "", false
+	}
+	return e.Placeholder, true
+}
+
+// metaValid 判断探测结果是否仍然有效
+func (e *IndexEntry) metaValid(size int64, modTime time.Time) bool {
+	return e.fileValid(size, modTime) && e.Meta != nil
+}
+
+// xmpValid 判断 sidecar 读取结果是否仍然有效，revision 为 sidecar 当前的修订版本
+func (e *IndexEntry) xmpValid(revision string) bool {
+	// 读取失败时没有记录修订版本，sidecar 存在时总是重新读取
+	return e != nil && e.XMPData.Revision() == revision
+}
+
+// Index 持久化保存图片元数据，避免每次扫描都读取 sidecar 和探测尺寸
+//
+// 索引只是缓存，读写失败由实现记录日志，不影响扫描
+type Index interface {
+	// Get 返回路径的记录，没有记录时返回 nil
+	Get(path string) *IndexEntry
+	Put(path string, entry *IndexEntry)
+	Delete(path string)
+}
@@ -0,0 +1,73 @@
+package image
+
+import (
+	"main/internal/shared"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// lazyMeta 在首次访问时探测元数据，探测成功后不再重复探测
+type lazyMeta struct {
+	mu    sync.Mutex
+	load  func() (*shared.ImageMeta, error)
+	value *shared.ImageMeta
+	done  atomic.Bool
+}
+
+func resolvedMeta(meta *shared.ImageMeta) *lazyMeta {
+	m := &lazyMeta{value: meta}
+	m.done.Store(true)
+	if m.value == nil {
+		m.value = &shared.ImageMeta{}
+	}
+	return m
+}
+
+func (m *lazyMeta) get() *shared.ImageMeta {
+	if m.done.Load() {
+		return m.value
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.done.Load() {
+		return m.value
+	}
+
+	meta, err := m.load()
+	if err != nil || meta == nil {
+		// 探测失败（如文件正在写入）时尺寸为 0，是否重新探测由 load 决定
+		return &shared.ImageMeta{}
+	}
+	m.value = meta
+	m.load = nil
+	m.done.Store(true)
+	return m.value
+}
+
+func (m *lazyMeta) resolved() bool {
+	return m.done.Load()
+}
+
+// ResolveMeta 并发探测尚未探测元数据的图片
+//
+// 批量读取尺寸前调用，避免逐个访问时串行等待探测
+func ResolveMeta(images []*Image) {
+	sem := make(chan struct{}, runtime.NumCPU())
+	var wg sync.WaitGroup
+	for _, img := range images {
+		if img == nil || img.MetaResolved() {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			img.meta.get()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,23 @@
+package image
+
+import "main/internal/apperror"
+
+// IsValidOrientation 判断是否为有效的 EXIF 方向
+func IsValidOrientation(orientation int) bool {
+	return orientation >= 1 && orientation <= 8
+}
+
+// OrientationSwapsAxes 判断方向是否需要交换宽高，即需要旋转 90 度显示
+func OrientationSwapsAxes(orientation int) bool {
+	return orientation >= 5 && orientation <= 8
+}
+
+// displaySize 将按 sourceOrientation 旋转后的尺寸转换为按 orientation 旋转后的尺寸
+func displaySize(width, height, sourceOrientation, orientation int) (int, int) {
+	if OrientationSwapsAxes(sourceOrientation) != OrientationSwapsAxes(orientation) {
+		return height, width
+	}
+	return width, height
+}
+
+var ErrInvalidOrientation = apperror.New("INVALID_ORIENTATION", "orientation must be between 1 and 8", "方向必须在 1 到 8 之间")
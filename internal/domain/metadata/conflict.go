@@ -0,0 +1,142 @@
+package metadata
+
+import (
+	"errors"
+	"main/internal/apperror"
+)
+
+// #region Write Options
+
+// WriteOptions 包含写入 sidecar 时的可选参数
+type WriteOptions struct {
+	expectedRevision    string
+	hasExpectedRevision bool
+	historyAction       string
+}
+
+// WriteOption 是用于设置 WriteOptions 的函数类型
+type WriteOption func(*WriteOptions)
+
+// NewWriteOptions 创建一个新的 WriteOptions 实例
+func NewWriteOptions(opts ...WriteOption) *WriteOptions {
+	o := &WriteOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithExpectedRevision 要求写入前 sidecar 的修订版本与读取时一致
+//
+// 空字符串表示读取时 sidecar 不存在
+func WithExpectedRevision(revision string) WriteOption {
+	return func(o *WriteOptions) {
+		o.expectedRevision = revision
+		o.hasExpectedRevision = true
+	}
+}
+
+// ExpectedRevision 返回期望的修订版本，第二个返回值表示是否需要检查
+func (o *WriteOptions) ExpectedRevision() (string, bool) {
+	return o.expectedRevision, o.hasExpectedRevision
+}
+
+// WithHistoryAction 设置编辑历史中记录的操作，默认为写入的图片操作
+//
+// 用于记录不改变图片操作的写入，如设置方向
+func WithHistoryAction(action string) WriteOption {
+	return func(o *WriteOptions) {
+		o.historyAction = action
+	}
+}
+
+// HistoryAction 返回编辑历史中记录的操作，空字符串表示使用写入的图片操作
+func (o *WriteOptions) HistoryAction() string {
+	return o.historyAction
+}
+
+// #endregion
+
+// NewErrXMPConflict 创建 sidecar 被外部修改导致的写入冲突错误
+func NewErrXMPConflict(imagePath string) error {
+	return apperror.New(
+		"XMP_CONFLICT",
+		"XMP sidecar modified externally: "+imagePath,
+		"XMP 文件已被外部修改: "+imagePath,
+	)
+}
+
+// IsXMPConflict 判断错误是否为写入冲突
+func IsXMPConflict(err error) bool {
+	return apperror.ErrCode(err) == "XMP_CONFLICT"
+}
+
+// UnparseableError 表示 sidecar 存在但无法解析
+//
+// 记录读取时的修订版本，写入时仍可检测外部修改，否则损坏的 sidecar 在默认策略下永远无法写入
+type UnparseableError struct {
+	revision string
+	err      error
+}
+
+// NewUnparseableError 创建 sidecar 解析错误，revision 为读取时的修订版本
+func NewUnparseableError(revision string, err error) error {
+	return &UnparseableError{revision: revision, err: err}
+}
+
+func (e *UnparseableError) Error() string {
+	return "failed to parse XMP: " + e.err.Error()
+}
+
+func (e *UnparseableError) Unwrap() error {
+	return e.err
+}
+
+// Revision 返回读取时 sidecar 的修订版本
+func (e *UnparseableError) Revision() string {
+	return e.revision
+}
+
+// UnparseableRevision 返回解析错误中记录的修订版本，不是解析错误时返回空字符串
+func UnparseableRevision(err error) string {
+	var unparseable *UnparseableError
+	if errors.As(err, &unparseable) {
+		return unparseable.revision
+	}
+	return ""
+}
+
+// MergeXMPData 三方合并本次写入的数据和外部修改
+//
+// base 为读取时的数据，theirs 为外部修改后的数据，ours 为本次要写入的数据。
+// 同一字段被双方修改为不同值时无法合并，第二个返回值为 false。
+// 操作和时间戳总是使用本次写入的值。
+func MergeXMPData(base, theirs, ours *XMPData) (*XMPData, bool) {
+	rating, ok := mergeValue(base.Rating(), theirs.Rating(), ours.Rating())
+	if !ok {
+		return nil, false
+	}
+
+	options := []XMPDataOption{WithSessionID(ours.SessionID())}
+	// 本次未修改备注时写入不会改动已有描述，无需合并
+	if ours.HasNote() {
+		note, ok := mergeValue(base.Note(), theirs.Note(), ours.Note())
+		if !ok {
+			return nil, false
+		}
+		options = append(options, WithNote(note))
+	}
+
+	return NewXMPData(rating, ours.Action(), ours.Timestamp(), options...), true
+}
+
+func mergeValue[T comparable](base, theirs, ours T) (T, bool) {
+	switch {
+	case theirs == base, theirs == ours:
+		return ours, true
+	case ours == base:
+		return theirs, true
+	default:
+		return ours, false
+	}
+}
@@ -0,0 +1,56 @@
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeXMPData(t *testing.T) {
+	now := time.Now()
+	base := NewXMPData(0, "", time.Time{}, WithNote("base"))
+
+	t.Run("only ours changed", func(t *testing.T) {
+		merged, ok := MergeXMPData(base, base, NewXMPData(5, "KEEP", now))
+		require.True(t, ok)
+		assert.Equal(t, 5, merged.Rating())
+		assert.Equal(t, "KEEP", merged.Action())
+		assert.False(t, merged.HasNote(), "Untouched note should be left to the repository")
+	})
+
+	t.Run("only theirs changed", func(t *testing.T) {
+		theirs := NewXMPData(3, "", time.Time{}, WithNote("theirs"))
+		merged, ok := MergeXMPData(base, theirs, NewXMPData(0, "KEEP", now, WithNote("base")))
+		require.True(t, ok)
+		assert.Equal(t, 3, merged.Rating())
+		assert.Equal(t, "theirs", merged.Note())
+	})
+
+	t.Run("both changed to same value", func(t *testing.T) {
+		theirs := NewXMPData(5, "", time.Time{})
+		merged, ok := MergeXMPData(base, theirs, NewXMPData(5, "KEEP", now))
+		require.True(t, ok)
+		assert.Equal(t, 5, merged.Rating())
+	})
+
+	t.Run("both changed rating", func(t *testing.T) {
+		theirs := NewXMPData(3, "", time.Time{})
+		_, ok := MergeXMPData(base, theirs, NewXMPData(5, "KEEP", now))
+		assert.False(t, ok)
+	})
+
+	t.Run("both changed note", func(t *testing.T) {
+		theirs := NewXMPData(0, "", time.Time{}, WithNote("theirs"))
+		_, ok := MergeXMPData(base, theirs, NewXMPData(0, "KEEP", now, WithNote("ours")))
+		assert.False(t, ok)
+	})
+
+	t.Run("no sidecar when loaded", func(t *testing.T) {
+		theirs := NewXMPData(0, "", time.Time{})
+		merged, ok := MergeXMPData(nil, theirs, NewXMPData(5, "KEEP", now))
+		require.True(t, ok)
+		assert.Equal(t, 5, merged.Rating())
+	})
+}
@@ -0,0 +1,14 @@
+package metadata
+
+import "time"
+
+// HistoryEvent 表示 XMP 编辑历史（xmpMM:History）中的一条记录
+//
+// 历史可能由其他工具写入，因此除 Action 外的字段都可能为空
+type HistoryEvent struct {
+	Action        string    // 操作，ImageFunnel 写入的是图片操作（如 KEEP）
+	Rating        *int      // 写入后的评分
+	Timestamp     time.Time // 操作时间
+	SoftwareAgent string    // 写入软件及版本
+	SessionID     string    // 写入时的会话 ID
+}
@@ -0,0 +1,28 @@
+package metadata
+
+import (
+	"strings"
+
+	"main/internal/apperror"
+	"main/internal/scalar"
+)
+
+const sidecarIssueIDPrefix = "sidecar-issue:"
+
+// EncodeSidecarIssueID 根据问题文件相对根目录的路径生成 ID
+func EncodeSidecarIssueID(relPath string) scalar.ID {
+	return scalar.ToID(sidecarIssueIDPrefix + relPath)
+}
+
+// DecodeSidecarIssueID 从 ID 中解析问题文件相对根目录的路径
+func DecodeSidecarIssueID(id scalar.ID) (string, error) {
+	idStr := id.String()
+	if idStr == "" {
+		return "", apperror.New("INVALID_ID", "id must not be empty", "ID 不能为空")
+	}
+	if !strings.HasPrefix(idStr, sidecarIssueIDPrefix) {
+		return "", apperror.New("INVALID_SIDECAR_ISSUE_ID", "invalid sidecar issue ID format", "sidecar 问题 ID 格式无效")
+	}
+
+	return strings.TrimPrefix(idStr, sidecarIssueIDPrefix), nil
+}
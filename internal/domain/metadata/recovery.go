@@ -0,0 +1,92 @@
+package metadata
+
+import (
+	"context"
+	"iter"
+	"main/internal/shared"
+	"time"
+)
+
+// SidecarIssue 表示一个需要修复的 sidecar 相关文件
+type SidecarIssue struct {
+	kind              shared.SidecarIssueKind
+	path              string
+	imagePath         string
+	sidecarRevision   string
+	modTime           time.Time
+	err               error
+	salvageableRating *int
+}
+
+// NewSidecarIssue 创建 sidecar 问题
+//
+// 参数：
+// - kind: 问题类型
+// - path: 问题文件的绝对路径
+// - imagePath: 所属图片的绝对路径
+// - sidecarRevision: 检查时所属图片 sidecar 的修订版本，sidecar 不存在时为空字符串
+// - modTime: 问题文件的修改时间
+// - err: 解析错误，没有时为 nil
+// - salvageableRating: 宽松解析得到的评分，无法提取时为 nil
+func NewSidecarIssue(kind shared.SidecarIssueKind, path, imagePath, sidecarRevision string, modTime time.Time, err error, salvageableRating *int) *SidecarIssue {
+	return &SidecarIssue{
+		kind:              kind,
+		path:              path,
+		imagePath:         imagePath,
+		sidecarRevision:   sidecarRevision,
+		modTime:           modTime,
+		err:               err,
+		salvageableRating: salvageableRating,
+	}
+}
+
+func (i *SidecarIssue) Kind() shared.SidecarIssueKind {
+	return i.kind
+}
+
+// Path 返回问题文件的绝对路径
+func (i *SidecarIssue) Path() string {
+	return i.path
+}
+
+// ImagePath 返回所属图片的绝对路径，图片本身可能已不存在
+func (i *SidecarIssue) ImagePath() string {
+	return i.imagePath
+}
+
+// SidecarRevision 返回检查时所属图片 sidecar 的修订版本
+//
+// 修复时用于检测检查后 sidecar 是否被修改，避免覆盖外部的修复
+func (i *SidecarIssue) SidecarRevision() string {
+	return i.sidecarRevision
+}
+
+func (i *SidecarIssue) ModTime() time.Time {
+	return i.modTime
+}
+
+// Err 返回解析错误
+func (i *SidecarIssue) Err() error {
+	return i.err
+}
+
+// SalvageableRating 返回宽松解析得到的评分，nil 表示无法提取
+func (i *SidecarIssue) SalvageableRating() *int {
+	return i.salvageableRating
+}
+
+// Recovery 负责检查和修复损坏的 sidecar
+type Recovery interface {
+	// Scan 递归列出目录下需要修复的文件
+	Scan(ctx context.Context, dir string) iter.Seq2[*SidecarIssue, error]
+	// Inspect 检查单个文件，返回 (nil, nil) 表示没有问题
+	Inspect(path string) (*SidecarIssue, error)
+	// Restore 用备份替换 sidecar，sidecar 在检查后被修改时返回 XMP_CONFLICT 错误
+	Restore(issue *SidecarIssue) error
+	// Salvage 宽松地从问题文件中提取评分并写入 sidecar，返回提取到的评分
+	//
+	// sidecar 在检查后被修改时返回 XMP_CONFLICT 错误
+	Salvage(issue *SidecarIssue) (int, error)
+	// Discard 删除问题文件，问题文件是 sidecar 且在检查后被修改时返回 XMP_CONFLICT 错误
+	Discard(issue *SidecarIssue) error
+}
@@ -3,5 +3,12 @@ package metadata
 type Repository interface {
 	// Read 返回 (nil, nil) 表示没有数据
 	Read(imagePath string) (*XMPData, error)
-	Write(imagePath string, data *XMPData) error
+	// Write 写入数据，保留 sidecar 中其他工具写入的字段
+	//
+	// 使用 WithExpectedRevision 时，如果 sidecar 在读取后被外部修改，返回 XMP_CONFLICT 错误
+	Write(imagePath string, data *XMPData, options ...WriteOption) error
+	// Revision 返回 sidecar 当前的修订版本而不读取内容，没有 sidecar 时返回空字符串
+	//
+	// 与 XMPData.Revision 一致，用于判断缓存的读取结果是否仍然有效
+	Revision(imagePath string) (string, error)
 }
@@ -1,8 +1,6 @@
 package metadata
 
 import (
-	"path/filepath"
-	"strings"
 	"time"
 )
 
@@ -10,6 +8,58 @@ type XMPData struct {
 	rating    int
 	action    string
 	timestamp time.Time
+	note      string
+	hasNote   bool
+	sessionID string
+	history   []HistoryEvent
+	revision  string
+
+	orientation    int
+	hasOrientation bool
+}
+
+// XMPDataOption 用于设置 XMPData 的可选字段
+type XMPDataOption func(*XMPData)
+
+// WithNote 设置备注（对应 dc:description 的 x-default 项）
+//
+// 未设置备注时写入不会修改已有的描述，设置为空字符串表示清除备注
+func WithNote(note string) XMPDataOption {
+	return func(d *XMPData) {
+		d.note = note
+		d.hasNote = true
+	}
+}
+
+// WithOrientation 设置方向（对应 tiff:Orientation，取值 1-8）
+//
+// 未设置方向时写入不会修改已有的方向，设置为 0 表示清除，此时使用原图中的方向
+func WithOrientation(orientation int) XMPDataOption {
+	return func(d *XMPData) {
+		d.orientation = orientation
+		d.hasOrientation = true
+	}
+}
+
+// WithSessionID 设置写入时的会话 ID，写入编辑历史
+func WithSessionID(sessionID string) XMPDataOption {
+	return func(d *XMPData) {
+		d.sessionID = sessionID
+	}
+}
+
+// WithHistory 设置已有的编辑历史，仅用于读取
+func WithHistory(history []HistoryEvent) XMPDataOption {
+	return func(d *XMPData) {
+		d.history = history
+	}
+}
+
+// WithRevision 设置读取时 sidecar 的修订版本，仅用于读取
+func WithRevision(revision string) XMPDataOption {
+	return func(d *XMPData) {
+		d.revision = revision
+	}
 }
 
 func (d *XMPData) Rating() (_ int) {
@@ -33,15 +83,74 @@ func (d *XMPData) Timestamp() (_ time.Time) {
 	return d.timestamp
 }
 
-func NewXMPData(rating int, action string, timestamp time.Time) *XMPData {
-	return &XMPData{
+// Note 返回备注，没有备注时返回空字符串
+func (d *XMPData) Note() (_ string) {
+	if d == nil {
+		return
+	}
+	return d.note
+}
+
+// HasNote 判断是否设置了备注
+func (d *XMPData) HasNote() (_ bool) {
+	if d == nil {
+		return
+	}
+	return d.hasNote
+}
+
+// Orientation 返回方向，没有设置时返回 0
+func (d *XMPData) Orientation() (_ int) {
+	if d == nil {
+		return
+	}
+	return d.orientation
+}
+
+// HasOrientation 判断是否设置了方向
+func (d *XMPData) HasOrientation() (_ bool) {
+	if d == nil {
+		return
+	}
+	return d.hasOrientation
+}
+
+// SessionID 返回写入时的会话 ID
+func (d *XMPData) SessionID() (_ string) {
+	if d == nil {
+		return
+	}
+	return d.sessionID
+}
+
+// History 返回编辑历史，按时间先后排列
+//
+// 写入时会忽略该字段，由仓库根据本次写入的数据追加新记录
+func (d *XMPData) History() (_ []HistoryEvent) {
+	if d == nil {
+		return
+	}
+	return d.history
+}
+
+// Revision 返回读取时 sidecar 的修订版本，用于写入时检测外部修改
+//
+// 没有 sidecar 时返回空字符串
+func (d *XMPData) Revision() (_ string) {
+	if d == nil {
+		return
+	}
+	return d.revision
+}
+
+func NewXMPData(rating int, action string, timestamp time.Time, options ...XMPDataOption) *XMPData {
+	d := &XMPData{
 		rating:    rating,
 		action:    action,
 		timestamp: timestamp,
 	}
-}
-
-func IsSupportedImage(filename string) bool {
-	ext := strings.ToLower(filepath.Ext(filename))
-	return ext == ".jpg" || ext == ".jpeg" || ext == ".png" || ext == ".webp" || ext == ".avif"
+	for _, opt := range options {
+		opt(d)
+	}
+	return d
 }
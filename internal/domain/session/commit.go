@@ -7,9 +7,12 @@ import (
 	"main/internal/apperror"
 	"main/internal/domain/image"
 	"main/internal/domain/metadata"
+	"main/internal/scalar"
 	"main/internal/shared"
 	"path/filepath"
 	"time"
+
+	"go.uber.org/zap"
 )
 
 // #region Session Getter
@@ -46,7 +49,9 @@ func (s *Service) Commit(ctx context.Context, session *Session, writeActions *sh
 	var successCount int
 
 	// 遍历所有持有且符合当前筛选条件的图片操作
+	committed := make(map[scalar.ID]bool)
 	for img, action := range session.Actions() {
+		committed[img.ID()] = true
 
 		var rating int
 		switch action {
@@ -58,65 +63,137 @@ func (s *Service) Commit(ctx context.Context, session *Session, writeActions *sh
 			rating = writeActions.RejectRating
 		}
 
-		// 显式重新加载图片最新状态
-		// Session 中存储的是绝对路径，而 Scanner.LookupImage 期望相对路径
-		relPath, err := filepath.Rel(s.rootDir, img.Path())
-		if err != nil {
-			errs = append(errs, err)
-			continue
+		options := []metadata.XMPDataOption{metadata.WithSessionID(session.ID().String())}
+		if note, ok := session.notes[img.ID()]; ok {
+			options = append(options, metadata.WithNote(note))
 		}
 
-		currentImg, err := s.dirScanner.LookupImage(ctx, relPath)
+		written, err := s.commitImage(ctx, session, img, writeActions.ConflictPolicy, func(*image.Image) *metadata.XMPData {
+			return metadata.NewXMPData(rating, action.String(), time.Now(), options...)
+		})
 		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
-
-		// 如果 ID 不匹配（说明文件已被外部修改），记录错误并跳过
-		if currentImg.ID() != img.ID() {
-			errs = append(errs, apperror.New(
-				"IMAGE_MODIFIED_EXTERNALLY",
-				"image ID mismatch (file modified externally): "+img.Path(),
-				"图片 ID 不匹配（文件已被外部修改）: "+img.Path(),
-			))
-			continue
+		if written {
+			successCount++
 		}
+	}
 
-		// 如果当前磁盘状态（即刚刚加载的状态）已经符合目标 Rating，跳过写入
-		if rating == currentImg.Rating() {
+	// 只有备注没有写入操作的图片（包括操作不符合当前筛选条件的），保留磁盘上原有的评分和操作
+	for img, note := range session.Notes() {
+		if committed[img.ID()] {
 			continue
 		}
 
-		xmpData := metadata.NewXMPData(rating, action.String(), time.Now())
-
-		if err := s.metadataRepo.Write(img.Path(), xmpData); err != nil {
+		written, err := s.commitImage(ctx, session, img, writeActions.ConflictPolicy, func(currentImg *image.Image) *metadata.XMPData {
+			return metadata.NewXMPData(
+				currentImg.Rating(),
+				currentImg.XMPData().Action(),
+				time.Now(),
+				metadata.WithNote(note),
+				metadata.WithSessionID(session.ID().String()),
+			)
+		})
+		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
-		successCount++
-
-		// 写入成功后，构建新的 Image 对象并直接更新内存
-		// 强制使用新 Rating，但保留原图其他信息（如 ModTime，等待 FileWatcher 慢慢更新）
-		newImg := image.NewImage(
-			currentImg.ID(),
-			currentImg.Filename(),
-			currentImg.Path(),
-			currentImg.Size(),
-			currentImg.ModTime(),
-			xmpData,
-			currentImg.Width(),
-			currentImg.Height(),
-		)
-
-		// 直接更新内存中的图片（已持有写锁）
-		if idx, ok := session.indexByID[img.ID()]; ok {
-			session.images[idx] = newImg
+		if written {
+			successCount++
 		}
 	}
 
+	clear(session.removed)
 	session.updatedAt = time.Now()
 
 	s.sessionSaved.Publish(ctx, session.ID())
 
 	return successCount, errors.Join(errs...)
 }
+
+// commitImage 重新加载图片最新状态并写入 XMP，返回是否实际写入
+//
+// build 根据磁盘上的最新图片构建要写入的数据，
+// policy 决定 sidecar 在会话加载图片后被外部修改时的处理方式
+func (s *Service) commitImage(
+	ctx context.Context,
+	session *Session,
+	img *image.Image,
+	policy shared.XMPConflictPolicy,
+	build func(currentImg *image.Image) *metadata.XMPData,
+) (bool, error) {
+	// 显式重新加载图片最新状态
+	// Session 中存储的是绝对路径，而 Scanner.LookupImage 期望相对路径
+	relPath, err := filepath.Rel(s.rootDir, img.Path())
+	if err != nil {
+		return false, err
+	}
+
+	currentImg, err := s.dirScanner.LookupImage(ctx, relPath)
+	if err != nil {
+		return false, err
+	}
+
+	// 如果 ID 不匹配（说明文件已被外部修改），记录错误并跳过
+	if currentImg.ID() != img.ID() {
+		return false, apperror.New(
+			"IMAGE_MODIFIED_EXTERNALLY",
+			"image ID mismatch (file modified externally): "+img.Path(),
+			"图片 ID 不匹配（文件已被外部修改）: "+img.Path(),
+		)
+	}
+
+	xmpData := build(currentImg)
+
+	// 基准记录了用户操作时的 sidecar 修订版本，由仓库在写入时校验
+	baseline := session.xmpBaseline(img)
+	var writeOptions []metadata.WriteOption
+	switch policy {
+	case shared.XMPConflictPolicyOverwrite:
+	case shared.XMPConflictPolicyMerge:
+		if currentImg.XMPRevision() != baseline.XMPRevision() {
+			merged, ok := metadata.MergeXMPData(baseline.XMPData(), currentImg.XMPData(), xmpData)
+			if !ok {
+				return false, metadata.NewErrXMPConflict(img.Path())
+			}
+			xmpData = merged
+		}
+		// 合并基于刚刚加载的状态，仍需防止加载后到写入前的修改
+		writeOptions = append(writeOptions, metadata.WithExpectedRevision(currentImg.XMPRevision()))
+	default:
+		writeOptions = append(writeOptions, metadata.WithExpectedRevision(baseline.XMPRevision()))
+	}
+
+	// 如果当前磁盘状态（即刚刚加载的状态）已经符合目标，跳过写入
+	if xmpData.Rating() == currentImg.Rating() &&
+		(!xmpData.HasNote() || xmpData.Note() == currentImg.Note()) {
+		delete(session.xmpBaselines, img.ID())
+		return false, nil
+	}
+
+	if err := s.metadataRepo.Write(img.Path(), xmpData, writeOptions...); err != nil {
+		return false, err
+	}
+
+	// 重新读取写入结果，以获得仓库合并后的完整数据（如保留的备注、追加的编辑历史）
+	if written, err := s.metadataRepo.Read(img.Path()); err != nil {
+		s.logger.Warn("failed to reload written metadata",
+			zap.String("path", img.Path()),
+			zap.Error(err))
+	} else if written != nil {
+		xmpData = written
+	}
+
+	// 写入成功后，基于最新图片替换 XMP 数据并直接更新内存
+	// 保留原图其他信息（如 ModTime，等待 FileWatcher 慢慢更新）
+	newImg := currentImg.WithXMPData(xmpData)
+
+	// 直接更新内存中的图片（已持有写锁）
+	if idx, ok := session.indexByID[img.ID()]; ok {
+		session.images[idx] = newImg
+	}
+	delete(session.xmpBaselines, img.ID())
+
+	return true, nil
+}
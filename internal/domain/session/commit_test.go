@@ -2,19 +2,17 @@ package session
 
 import (
 	"context"
+	"errors"
 	"main/internal/domain/image"
 	"main/internal/domain/metadata"
-	"main/internal/pubsub"
 	"main/internal/scalar"
 	"main/internal/shared"
-	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"go.uber.org/zap"
 )
 
 func TestCanCommit_InitialState_ShouldReturnFalse(t *testing.T) {
@@ -99,41 +97,12 @@ func TestActions_ShouldOnlyReturnMarkedImages(t *testing.T) {
 }
 
 func TestService_Commit_ShouldOnlyWriteMatchingImages(t *testing.T) {
-	tempDir, err := os.MkdirTemp("", "session_test")
-	require.NoError(t, err)
-	defer os.RemoveAll(tempDir)
-
-	file1 := filepath.Join(tempDir, "test1.jpg")
-	file2 := filepath.Join(tempDir, "test2.jpg")
-	file3 := filepath.Join(tempDir, "test3.jpg")
-	os.WriteFile(file1, []byte("fake"), 0644)
-	os.WriteFile(file2, []byte("fake"), 0644)
-	os.WriteFile(file3, []byte("fake"), 0644)
-
-	fakeMeta := NewFakeMetadataRepo()
-	fakeSessionRepo := NewFakeSessionRepo()
-	fakeEventBus := &FakeEventBus{}
-	topic, cleanup := pubsub.NewInMemoryTopic[scalar.ID]()
-	defer cleanup()
-
-	fakeScanner := &FakeScanner{
-		MetaRepo: fakeMeta,
-		BaseDir:  tempDir,
-		Images:   make(map[string]*image.Image),
-	}
-
-	svc, cleanupService := NewService(fakeSessionRepo, fakeMeta, fakeScanner, fakeEventBus, zap.NewNop(), topic, tempDir)
-	defer cleanupService()
-
+	svc := setupTestService(t)
 	filter := &shared.ImageFilters{Rating: []int{0}}
 
-	img1 := image.NewImage(scalar.ToID("1"), "test1.jpg", file1, 100, time.Now(), metadata.NewXMPData(0, "", time.Time{}), 100, 100)
-	img2 := image.NewImage(scalar.ToID("2"), "test2.jpg", file2, 100, time.Now(), metadata.NewXMPData(3, "", time.Time{}), 100, 100)
-	img3 := image.NewImage(scalar.ToID("3"), "test3.jpg", file3, 100, time.Now(), metadata.NewXMPData(0, "", time.Time{}), 100, 100)
-
-	fakeScanner.Images[filepath.Base(img1.Path())] = img1
-	fakeScanner.Images[filepath.Base(img2.Path())] = img2
-	fakeScanner.Images[filepath.Base(img3.Path())] = img3
+	img1 := svc.AddImage(t, "test1.jpg", metadata.NewXMPData(0, "", time.Time{}))
+	img2 := svc.AddImage(t, "test2.jpg", metadata.NewXMPData(3, "", time.Time{}))
+	img3 := svc.AddImage(t, "test3.jpg", metadata.NewXMPData(0, "", time.Time{}))
 
 	sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), filter, 10, []*image.Image{img1, img3})
 
@@ -158,42 +127,21 @@ func TestService_Commit_ShouldOnlyWriteMatchingImages(t *testing.T) {
 	require.Empty(t, errs)
 	require.Equal(t, 2, success, "Should successfully write matching images")
 
-	require.Contains(t, fakeMeta.Data, file1)
-	require.Equal(t, 5, fakeMeta.Data[file1].Rating())
-	require.Equal(t, shared.ImageActionKeep.String(), fakeMeta.Data[file1].Action())
+	require.Contains(t, svc.MetaRepo.Data, img1.Path())
+	require.Equal(t, 5, svc.MetaRepo.Data[img1.Path()].Rating())
+	require.Equal(t, shared.ImageActionKeep.String(), svc.MetaRepo.Data[img1.Path()].Action())
+	require.Equal(t, "s1", svc.MetaRepo.Data[img1.Path()].SessionID(), "Session ID should be recorded for the edit history")
 
-	require.NotContains(t, fakeMeta.Data, file2)
+	require.NotContains(t, svc.MetaRepo.Data, img2.Path())
 
-	require.Contains(t, fakeMeta.Data, file3)
-	require.Equal(t, -1, fakeMeta.Data[file3].Rating())
-	require.Equal(t, shared.ImageActionReject.String(), fakeMeta.Data[file3].Action())
+	require.Contains(t, svc.MetaRepo.Data, img3.Path())
+	require.Equal(t, -1, svc.MetaRepo.Data[img3.Path()].Rating())
+	require.Equal(t, shared.ImageActionReject.String(), svc.MetaRepo.Data[img3.Path()].Action())
 }
 
 func TestService_Commit_UpdatesInMemoryState(t *testing.T) {
-	tempDir, err := os.MkdirTemp("", "session_test_memory")
-	require.NoError(t, err)
-	defer os.RemoveAll(tempDir)
-
-	file1 := filepath.Join(tempDir, "test1.jpg")
-	os.WriteFile(file1, []byte("fake"), 0644)
-
-	fakeMeta := NewFakeMetadataRepo()
-	fakeSessionRepo := NewFakeSessionRepo()
-	fakeEventBus := &FakeEventBus{}
-	topic, cleanup := pubsub.NewInMemoryTopic[scalar.ID]()
-	defer cleanup()
-
-	fakeScanner := &FakeScanner{
-		MetaRepo: fakeMeta,
-		BaseDir:  tempDir,
-		Images:   make(map[string]*image.Image),
-	}
-
-	svc, cleanupService := NewService(fakeSessionRepo, fakeMeta, fakeScanner, fakeEventBus, zap.NewNop(), topic, tempDir)
-	defer cleanupService()
-
-	img1 := image.NewImage(scalar.ToID("1"), "test1.jpg", file1, 100, time.Now(), metadata.NewXMPData(0, "", time.Time{}), 100, 100)
-	fakeScanner.Images[filepath.Base(img1.Path())] = img1
+	svc := setupTestService(t)
+	img1 := svc.AddImage(t, "test1.jpg", metadata.NewXMPData(0, "", time.Time{}))
 
 	filter := &shared.ImageFilters{Rating: []int{0}}
 	sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), filter, 10, []*image.Image{img1})
@@ -210,9 +158,170 @@ func TestService_Commit_UpdatesInMemoryState(t *testing.T) {
 	require.Empty(t, errs)
 	require.Equal(t, 1, success)
 
-	require.Equal(t, 5, fakeMeta.Data[file1].Rating())
+	require.Equal(t, 5, svc.MetaRepo.Data[img1.Path()].Rating())
 
 	idx := sess.indexByID[img1.ID()]
 	inMemImg := sess.images[idx]
 	require.Equal(t, 5, inMemImg.Rating(), "In-memory image rating should be updated after commit")
 }
+
+func TestService_Commit_ConflictPolicy(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         shared.XMPConflictPolicy
+		externalRating int
+		wantConflict   bool
+		wantRating     int
+	}{
+		{"default skips", shared.XMPConflictPolicy{}, 2, true, 2},
+		{"skip", shared.XMPConflictPolicySkip, 2, true, 2},
+		{"overwrite", shared.XMPConflictPolicyOverwrite, 2, false, 5},
+		{"merge conflicting rating", shared.XMPConflictPolicyMerge, 2, true, 2},
+		{"merge unchanged rating", shared.XMPConflictPolicyMerge, 0, false, 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestService(t)
+			img1 := svc.AddImage(t, "test1.jpg", nil)
+
+			sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), nil, 10, []*image.Image{img1})
+			require.NoError(t, sess.MarkImage(img1.ID(), shared.ImageActionKeep))
+
+			// 会话加载后 sidecar 被外部工具修改
+			require.NoError(t, svc.MetaRepo.Write(img1.Path(), metadata.NewXMPData(tc.externalRating, "", time.Now())))
+
+			success, err := svc.Commit(context.Background(), sess, &shared.WriteActions{
+				KeepRating:     5,
+				ConflictPolicy: tc.policy,
+			})
+			if tc.wantConflict {
+				require.Error(t, err)
+				assert.True(t, metadata.IsXMPConflict(err))
+				assert.Equal(t, 0, success)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 1, success)
+			}
+			assert.Equal(t, tc.wantRating, svc.MetaRepo.Data[img1.Path()].Rating())
+		})
+	}
+}
+
+func TestService_Commit_UnparseableSidecar(t *testing.T) {
+	svc := setupTestService(t)
+	// sidecar 损坏时读不到数据，但文件存在且有修订版本
+	img1 := svc.AddImage(t, "test1.jpg", nil,
+		image.WithXMPError(metadata.NewUnparseableError("broken", errors.New("unexpected EOF"))),
+	)
+	svc.MetaRepo.Data[img1.Path()] = metadata.NewXMPData(0, "", time.Time{}, metadata.WithRevision("broken"))
+	require.Equal(t, "broken", img1.XMPRevision())
+
+	sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), nil, 10, []*image.Image{img1})
+	require.NoError(t, sess.MarkImage(img1.ID(), shared.ImageActionKeep))
+
+	success, err := svc.Commit(context.Background(), sess, &shared.WriteActions{KeepRating: 5})
+	require.NoError(t, err)
+	assert.Equal(t, 1, success)
+	assert.Equal(t, 5, svc.MetaRepo.Data[img1.Path()].Rating())
+}
+
+func TestService_Commit_ShouldDetectConflictAfterImageRefresh(t *testing.T) {
+	testCases := []struct {
+		name         string
+		markBefore   bool // 在 sidecar 被外部修改之前操作
+		wantConflict bool
+	}{
+		{"marked before external edit", true, true},
+		{"marked after external edit", false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestService(t)
+			path := filepath.Join(svc.Dir, "test1.jpg")
+			require.NoError(t, svc.MetaRepo.Write(path, metadata.NewXMPData(0, "", time.Now())))
+			img1 := svc.AddImage(t, "test1.jpg", svc.MetaRepo.Data[path])
+
+			sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), nil, 10, []*image.Image{img1})
+			if tc.markBefore {
+				require.NoError(t, sess.MarkImage(img1.ID(), shared.ImageActionKeep))
+			}
+
+			// 外部工具修改 sidecar 后，文件监听重新读取图片
+			require.NoError(t, svc.MetaRepo.Write(path, metadata.NewXMPData(2, "", time.Now())))
+			refreshed, err := svc.Scanner.LookupImage(context.Background(), "test1.jpg")
+			require.NoError(t, err)
+			require.True(t, sess.UpdateImage(refreshed, true))
+			assert.Equal(t, 2, sess.images[sess.indexByID[img1.ID()]].Rating(), "session should display the latest rating")
+
+			if !tc.markBefore {
+				require.NoError(t, sess.MarkImage(img1.ID(), shared.ImageActionKeep))
+			}
+
+			success, err := svc.Commit(context.Background(), sess, &shared.WriteActions{KeepRating: 5})
+			if tc.wantConflict {
+				require.Error(t, err)
+				assert.True(t, metadata.IsXMPConflict(err))
+				assert.Equal(t, 0, success)
+				assert.Equal(t, 2, svc.MetaRepo.Data[path].Rating())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 1, success)
+				assert.Equal(t, 5, svc.MetaRepo.Data[path].Rating())
+			}
+		})
+	}
+}
+
+func TestService_Commit_ConflictPolicy_AfterFileChange(t *testing.T) {
+	testCases := []struct {
+		name         string
+		policy       shared.XMPConflictPolicy
+		external     *metadata.XMPData
+		wantConflict bool
+		wantRating   int
+		wantNote     string
+	}{
+		{"default skips", shared.XMPConflictPolicy{}, metadata.NewXMPData(2, "", time.Now()), true, 2, ""},
+		{"overwrite", shared.XMPConflictPolicyOverwrite, metadata.NewXMPData(2, "", time.Now()), false, 5, ""},
+		{"merge conflicting rating", shared.XMPConflictPolicyMerge, metadata.NewXMPData(2, "", time.Now()), true, 2, ""},
+		{"merge external note", shared.XMPConflictPolicyMerge, metadata.NewXMPData(0, "", time.Now(), metadata.WithNote("from lightroom")), false, 5, "from lightroom"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := setupTestService(t)
+			path := filepath.Join(svc.Dir, "test1.jpg")
+			require.NoError(t, svc.MetaRepo.Write(path, metadata.NewXMPData(0, "", time.Now())))
+			img1 := svc.AddImage(t, "test1.jpg", svc.MetaRepo.Data[path])
+
+			sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), nil, 10, []*image.Image{img1})
+			_, err := svc.Repo.Create(sess)
+			require.NoError(t, err)
+			require.NoError(t, sess.MarkImage(img1.ID(), shared.ImageActionKeep))
+
+			// 外部工具修改 sidecar，文件监听将其转换为图片的更新
+			require.NoError(t, svc.MetaRepo.Write(path, tc.external))
+			require.NoError(t, svc.handleFileChange(ctx, &shared.FileChangedEvent{
+				DirectoryID: scalar.ToID("d1"),
+				RelPath:     "test1.jpg",
+				Action:      shared.FileActionWrite,
+			}))
+			require.Equal(t, svc.MetaRepo.Data[path].Revision(), sess.images[sess.indexByID[img1.ID()]].XMPRevision())
+
+			success, err := svc.Commit(ctx, sess, &shared.WriteActions{KeepRating: 5, ConflictPolicy: tc.policy})
+			if tc.wantConflict {
+				require.Error(t, err)
+				assert.True(t, metadata.IsXMPConflict(err))
+				assert.Equal(t, 0, success)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 1, success)
+			}
+			assert.Equal(t, tc.wantRating, svc.MetaRepo.Data[path].Rating())
+			assert.Equal(t, tc.wantNote, svc.MetaRepo.Data[path].Note())
+		})
+	}
+}
@@ -2,38 +2,281 @@ package session
 
 import (
 	"context"
+	"iter"
+	"main/internal/apperror"
 	"main/internal/domain/directory"
 	"main/internal/domain/image"
 	"main/internal/scalar"
 	"main/internal/shared"
+	"time"
+
+	"go.uber.org/zap"
 )
 
+// loadBatchInterval 是加载时将扫描结果加入会话的间隔，合并发布避免每张图片都通知订阅者
+const loadBatchInterval = 200 * time.Millisecond
+
+var ErrSessionLoading = apperror.New("INVALID_OPERATION", "session is still loading", "会话仍在加载")
+
+// LoadProgress 是创建会话时扫描目录的进度
+type LoadProgress struct {
+	State          shared.SessionLoadState
+	FilesSeen      int   // 已扫描的图片数量
+	ImagesAccepted int   // 其中符合过滤条件的数量
+	Err            error // 扫描失败的原因
+}
+
+// LoadProgressEvent 是会话加载进度的变化
+//
+// 加载期间每批图片发布一次，订阅者不需要读取整个会话
+type LoadProgressEvent struct {
+	SessionID scalar.ID
+	Progress  LoadProgress
+}
+
+// loader 是正在后台扫描目录的会话
+type loader struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// #region Session Methods
+
+// LoadProgress 返回扫描目录的进度
+func (s *Session) LoadProgress() LoadProgress {
+	return s.loadProgress
+}
+
+// Loading 判断是否正在扫描目录
+func (s *Session) Loading() bool {
+	return s.loadProgress.State == shared.SessionLoadStateLoading
+}
+
+// appendLoaded 将扫描到的图片加入队列末尾，seen 为本批扫描的图片数量（包括不符合过滤条件的）
+func (s *Session) appendLoaded(images []*image.Image, seen int) {
+	for _, img := range images {
+		// 文件监听可能已经加入了更新的版本
+		if _, ok := s.indexByPath[img.Path()]; ok {
+			continue
+		}
+		idx := len(s.images)
+		s.images = append(s.images, img)
+		s.indexByID[img.ID()] = idx
+		s.indexByPath[img.Path()] = idx
+		s.queue = append(s.queue, idx)
+	}
+	s.loadProgress.FilesSeen += seen
+	s.loadProgress.ImagesAccepted += len(images)
+	s.updatedAt = time.Now()
+}
+
+// finishLoading 结束加载，加载期间已经处理完队列时检查是否需要开启新一轮
+func (s *Session) finishLoading(state shared.SessionLoadState, err error) error {
+	s.loadProgress.State = state
+	s.loadProgress.Err = err
+	s.updatedAt = time.Now()
+	return s.checkRoundEnd()
+}
+
+// #endregion
+
 // Create 初始化一个新的会话
-// 扫描目录、应用过滤器并创建会话
+//
+// 会话创建后立即返回，状态为加载中。目录在后台扫描，符合过滤器的图片陆续加入队列，
+// 第一批图片加入后即可开始筛选，扫描可以通过 CancelCreation 取消
 func (s *Service) Create(ctx context.Context, id scalar.ID, directoryID scalar.ID, filter *shared.ImageFilters, targetKeep int) error {
 	directory, err := directory.DecodeID(directoryID)
 	if err != nil {
 		return err
 	}
 
-	filterFunc := image.BuildImageFilter(filter)
-	var filteredImages []*image.Image
+	// 先登记扫描任务，保证加载中的会话总能找到对应的任务
+	loadCtx, cancel := context.WithCancel(s.ctx)
+	l := &loader{cancel: cancel, done: make(chan struct{})}
+	s.loadersMu.Lock()
+	s.loaders[id] = l
+	s.loadersMu.Unlock()
+
+	sess := NewSession(id, directoryID, filter, targetKeep, nil)
+	sess.loadProgress.State = shared.SessionLoadStateLoading
+	release, err := s.sessionRepo.Create(sess)
+	if err != nil {
+		s.loadersMu.Lock()
+		delete(s.loaders, id)
+		s.loadersMu.Unlock()
+		cancel()
+		return err
+	}
+	release()
+
+	go s.load(loadCtx, l, id, directory, image.BuildImageFilter(filter))
+
+	s.sessionSaved.Publish(ctx, id)
+	return nil
+}
+
+// load 扫描目录并分批加入会话
+func (s *Service) load(ctx context.Context, l *loader, id scalar.ID, directory string, filterFunc func(*image.Image) bool) {
+	defer func() {
+		s.loadersMu.Lock()
+		delete(s.loaders, id)
+		s.loadersMu.Unlock()
+		l.cancel()
+		close(l.done)
+	}()
+
+	var batch []*image.Image
+	var seen int
+	var lastFlush time.Time // 第一张图片立即加入
+	var scanErr error
 	for img, err := range s.dirScanner.Scan(ctx, directory) {
 		if err != nil {
-			return err
+			scanErr = err
+			break
 		}
+		seen++
 		if filterFunc(img) {
-			filteredImages = append(filteredImages, img)
+			batch = append(batch, img)
+		}
+		if time.Since(lastFlush) < loadBatchInterval {
+			continue
 		}
+		if !s.updateLoading(id, func(sess *Session) error {
+			sess.appendLoaded(batch, seen)
+			return nil
+		}) {
+			return
+		}
+		batch, seen = nil, 0
+		lastFlush = time.Now()
 	}
 
-	sess := NewSession(id, directoryID, filter, targetKeep, filteredImages)
-	release, err := s.sessionRepo.Create(sess)
+	state := shared.SessionLoadStateReady
+	if ctx.Err() != nil {
+		state = shared.SessionLoadStateCancelled
+		scanErr = nil
+	} else if scanErr != nil {
+		state = shared.SessionLoadStateFailed
+		s.logger.Error("failed to load session",
+			zap.Stringer("sessionID", id),
+			zap.String("directory", directory),
+			zap.Error(scanErr))
+	}
+	s.updateLoading(id, func(sess *Session) error {
+		sess.appendLoaded(batch, seen)
+		return sess.finishLoading(state, scanErr)
+	})
+}
+
+// updateLoading 修改加载中的会话并发布进度，会话已不存在时返回 false
+//
+// 只有队列从空变为非空（可以继续筛选）或加载结束时才发布会话更新，其余批次只发布进度
+func (s *Service) updateLoading(id scalar.ID, update func(sess *Session) error) bool {
+	// 取消扫描后仍需写入最后一批图片和结束状态
+	ctx := context.WithoutCancel(s.ctx)
+	sess, release, err := s.sessionRepo.Acquire(ctx, id)
 	if err != nil {
-		return err
+		s.logger.Warn("session removed while loading", zap.Stringer("sessionID", id), zap.Error(err))
+		return false
 	}
 	defer release()
 
-	s.sessionSaved.Publish(ctx, sess.ID())
-	return nil
+	waiting := sess.CurrentImage() == nil
+	if err := update(sess); err != nil {
+		s.logger.Error("failed to update loading session", zap.Stringer("sessionID", id), zap.Error(err))
+	}
+	s.loadProgress.Publish(ctx, LoadProgressEvent{SessionID: id, Progress: sess.LoadProgress()})
+	if !sess.Loading() || (waiting && sess.CurrentImage() != nil) {
+		s.sessionSaved.Publish(ctx, id)
+	}
+	return true
+}
+
+// SubscribeLoadProgress 订阅会话的加载进度
+//
+// 首先返回当前进度，会话不在加载中时随即结束；否则持续返回进度，加载结束时返回最终进度后结束
+func (s *Service) SubscribeLoadProgress(ctx context.Context, id scalar.ID) iter.Seq2[LoadProgress, error] {
+	return func(yield func(LoadProgress, error) bool) {
+		// 任务在会话创建前登记、加载结束后移除，因此先于进度读取
+		s.loadersMu.Lock()
+		l := s.loaders[id]
+		s.loadersMu.Unlock()
+
+		progress, err := s.currentLoadProgress(ctx, id)
+		if err != nil {
+			yield(LoadProgress{}, err)
+			return
+		}
+		if !yield(progress, nil) || progress.State != shared.SessionLoadStateLoading || l == nil {
+			return
+		}
+
+		// 订阅开始前发布的进度会丢失，扫描结束后重新读取最终进度，避免错过结束事件
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-l.done:
+				cancel()
+			case <-watchCtx.Done():
+			}
+		}()
+		for event, err := range s.loadProgress.Subscribe(watchCtx) {
+			if err != nil {
+				if watchCtx.Err() != nil {
+					break
+				}
+				if !yield(LoadProgress{}, err) {
+					return
+				}
+				continue
+			}
+			if event.SessionID != id {
+				continue
+			}
+			if !yield(event.Progress, nil) || event.Progress.State != shared.SessionLoadStateLoading {
+				return
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		yield(s.currentLoadProgress(ctx, id))
+	}
+}
+
+func (s *Service) currentLoadProgress(ctx context.Context, id scalar.ID) (LoadProgress, error) {
+	sess, release, err := s.sessionRepo.Acquire(ctx, id)
+	if err != nil {
+		return LoadProgress{}, err
+	}
+	defer release()
+	return sess.LoadProgress(), nil
+}
+
+// CancelCreation 取消会话的目录扫描，等待扫描停止后返回
+//
+// 已扫描到的图片保留在会话中，会话已加载完成时不做任何操作
+func (s *Service) CancelCreation(ctx context.Context, id scalar.ID) error {
+	// 确认会话存在
+	_, release, err := s.sessionRepo.Acquire(ctx, id)
+	if err != nil {
+		return err
+	}
+	release()
+
+	s.loadersMu.Lock()
+	l := s.loaders[id]
+	s.loadersMu.Unlock()
+	if l == nil {
+		return nil
+	}
+
+	l.cancel()
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
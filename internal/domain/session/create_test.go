@@ -0,0 +1,173 @@
+package session
+
+import (
+	"context"
+	"main/internal/domain/directory"
+	"main/internal/pubsub"
+	"main/internal/scalar"
+	"main/internal/shared"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSession_Loading_ShouldDeferNextRound(t *testing.T) {
+	images := createTestImages(3)
+	session := NewSession(scalar.ToID("test-id"), scalar.ToID("test-dir-id"), &shared.ImageFilters{Rating: []int{0}}, 1, nil)
+	session.loadProgress.State = shared.SessionLoadStateLoading
+
+	session.appendLoaded(images[:2], 2)
+	markImagesInSession(t, session, func(int) shared.ImageAction { return shared.ImageActionKeep })
+
+	// 加载中处理完队列时不开启新一轮
+	assert.Equal(t, 0, session.currentRound)
+	assert.Nil(t, session.CurrentImage())
+	assert.False(t, session.Stats().IsCompleted)
+
+	// 后续加入的图片直接成为当前图片
+	session.appendLoaded(images[2:], 1)
+	assert.Equal(t, images[2].ID(), session.CurrentImage().ID())
+	require.NoError(t, session.MarkImage(images[2].ID(), shared.ImageActionReject))
+	assert.Equal(t, 0, session.currentRound)
+
+	require.NoError(t, session.finishLoading(shared.SessionLoadStateReady, nil))
+	assert.Equal(t, 1, session.currentRound)
+	assert.Equal(t, 2, session.CurrentSize())
+	assert.Equal(t, LoadProgress{State: shared.SessionLoadStateReady, FilesSeen: 3, ImagesAccepted: 3}, session.LoadProgress())
+}
+
+func TestSession_AppendLoaded_ShouldSkipKnownPaths(t *testing.T) {
+	images := createTestImages(2)
+	session := NewSession(scalar.ToID("test-id"), scalar.ToID("test-dir-id"), &shared.ImageFilters{}, 1, images[:1])
+
+	// 文件监听已经加入的图片不重复加入
+	session.appendLoaded(images, 2)
+	assert.Equal(t, 2, session.CurrentSize())
+	assert.Len(t, ImagesOf(session), 2)
+}
+
+func newLoadingTestService(t *testing.T, scanner *FakeScanner) (*Service, *FakeSessionRepo) {
+	fakeSessionRepo := NewFakeSessionRepo()
+	topic, cleanup := pubsub.NewInMemoryTopic[scalar.ID]()
+	t.Cleanup(cleanup)
+
+	service, stop := NewService(fakeSessionRepo, NewFakeMetadataRepo(), scanner, &FakeEventBus{}, zap.NewNop(), topic, newLoadProgressTopic(t), "/test")
+	t.Cleanup(stop)
+	return service, fakeSessionRepo
+}
+
+func TestService_Create_ShouldLoadInBackground(t *testing.T) {
+	scanner := &FakeScanner{
+		ScanImages: createTestImagesWithRatings([]int{0, 3, 0, 0}),
+		ScanGate:   make(chan struct{}),
+	}
+	service, repo := newLoadingTestService(t, scanner)
+	id := scalar.ToID("test-id")
+
+	err := service.Create(context.Background(), id, directory.EncodeID("."), &shared.ImageFilters{Rating: []int{0}}, 1)
+	require.NoError(t, err)
+
+	// 扫描未完成时立即返回
+	session := repo.Sessions[id]
+	require.NotNil(t, session)
+	assert.True(t, session.Loading())
+	assert.Equal(t, 0, session.CurrentSize())
+
+	service.loadersMu.Lock()
+	l := service.loaders[id]
+	service.loadersMu.Unlock()
+	require.NotNil(t, l)
+	close(scanner.ScanGate)
+	<-l.done
+
+	assert.Equal(t, LoadProgress{State: shared.SessionLoadStateReady, FilesSeen: 4, ImagesAccepted: 3}, session.LoadProgress())
+	assert.Equal(t, 3, session.CurrentSize())
+
+	// 已加载完成时取消不做任何操作
+	require.NoError(t, service.CancelCreation(context.Background(), id))
+	assert.Equal(t, shared.SessionLoadStateReady, session.LoadProgress().State)
+}
+
+func TestService_CancelCreation_ShouldKeepScannedImages(t *testing.T) {
+	scanner := &FakeScanner{
+		ScanImages: createTestImages(3),
+		ScanGate:   make(chan struct{}),
+	}
+	service, repo := newLoadingTestService(t, scanner)
+	id := scalar.ToID("test-id")
+
+	err := service.Create(context.Background(), id, directory.EncodeID("."), &shared.ImageFilters{}, 1)
+	require.NoError(t, err)
+	scanner.ScanGate <- struct{}{}
+
+	require.NoError(t, service.CancelCreation(context.Background(), id))
+
+	session := repo.Sessions[id]
+	assert.Equal(t, LoadProgress{State: shared.SessionLoadStateCancelled, FilesSeen: 1, ImagesAccepted: 1}, session.LoadProgress())
+	assert.Equal(t, 1, session.CurrentSize())
+
+	scanner.ScanGate = nil
+	err = service.Update(context.Background(), id, WithFilter(&shared.ImageFilters{}))
+	assert.NoError(t, err, "cancelled session should accept a new round")
+}
+
+func TestService_SubscribeLoadProgress_ShouldReturnCurrentProgress(t *testing.T) {
+	scanner := &FakeScanner{ScanImages: createTestImages(2)}
+	service, _ := newLoadingTestService(t, scanner)
+	id := scalar.ToID("test-id")
+
+	require.NoError(t, service.Create(context.Background(), id, directory.EncodeID("."), &shared.ImageFilters{}, 1))
+	service.loadersMu.Lock()
+	l := service.loaders[id]
+	service.loadersMu.Unlock()
+	if l != nil {
+		<-l.done
+	}
+
+	// 已加载完成时只返回当前进度
+	var events []LoadProgress
+	for progress, err := range service.SubscribeLoadProgress(context.Background(), id) {
+		require.NoError(t, err)
+		events = append(events, progress)
+	}
+	assert.Equal(t, []LoadProgress{{State: shared.SessionLoadStateReady, FilesSeen: 2, ImagesAccepted: 2}}, events)
+}
+
+func TestService_SubscribeLoadProgress_ShouldEndWithFinalProgress(t *testing.T) {
+	scanner := &FakeScanner{
+		ScanImages: createTestImages(3),
+		ScanGate:   make(chan struct{}),
+	}
+	service, _ := newLoadingTestService(t, scanner)
+	id := scalar.ToID("test-id")
+
+	require.NoError(t, service.Create(context.Background(), id, directory.EncodeID("."), &shared.ImageFilters{}, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := make(chan LoadProgress)
+	go func() {
+		defer close(events)
+		for progress, err := range service.SubscribeLoadProgress(ctx, id) {
+			if err != nil {
+				return
+			}
+			events <- progress
+		}
+	}()
+
+	// 首先返回当前进度
+	first := <-events
+	assert.Equal(t, shared.SessionLoadStateLoading, first.State)
+
+	close(scanner.ScanGate)
+	var last LoadProgress
+	for progress := range events {
+		last = progress
+	}
+	require.NoError(t, ctx.Err(), "subscription should end after loading")
+	assert.Equal(t, LoadProgress{State: shared.SessionLoadStateReady, FilesSeen: 3, ImagesAccepted: 3}, last)
+}
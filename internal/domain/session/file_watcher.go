@@ -3,9 +3,12 @@ package session
 import (
 	"context"
 	"main/internal/domain/image"
+	"main/internal/scalar"
 	"main/internal/shared"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"go.uber.org/zap"
 )
@@ -27,6 +30,10 @@ func (s *Service) subscribeFileChanges(ctx context.Context) {
 }
 
 func (s *Service) handleFileChange(ctx context.Context, e *shared.FileChangedEvent) error {
+	if e.Action == shared.FileActionRename && e.OldRelPath != "" {
+		return s.handleRename(ctx, e)
+	}
+
 	var img *image.Image
 	if e.Action == shared.FileActionCreate || e.Action == shared.FileActionWrite {
 		var err error
@@ -71,3 +78,68 @@ func (s *Service) handleFileChange(ctx context.Context, e *shared.FileChangedEve
 
 	return nil
 }
+
+// handleRename 将重命名或移动的图片在会话中迁移到新路径
+//
+// 同时包含新旧目录的会话迁移图片和操作记录，只包含其中之一的会话按删除或新增处理
+func (s *Service) handleRename(ctx context.Context, e *shared.FileChangedEvent) error {
+	img, err := s.dirScanner.LookupImage(ctx, e.RelPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	oldPath := filepath.Join(s.rootDir, e.OldRelPath)
+
+	inOld := make(map[scalar.ID]bool)
+	for sessionID, err := range s.sessionRepo.FindByDirectory(e.OldDirectoryID) {
+		if err != nil {
+			return err
+		}
+		inOld[sessionID] = true
+	}
+	inNew := make(map[scalar.ID]bool)
+	for sessionID, err := range s.sessionRepo.FindByDirectory(e.DirectoryID) {
+		if err != nil {
+			return err
+		}
+		inNew[sessionID] = true
+	}
+
+	sessionIDs := slices.Collect(maps.Keys(inOld))
+	for sessionID := range inNew {
+		if !inOld[sessionID] {
+			sessionIDs = append(sessionIDs, sessionID)
+		}
+	}
+
+	for _, sessionID := range sessionIDs {
+		sess, release, err := s.sessionRepo.Acquire(ctx, sessionID)
+		if err != nil {
+			s.logger.Error("failed to take ownership of session",
+				zap.Stringer("sessionID", sessionID),
+				zap.Error(err))
+			continue
+		}
+
+		changed := false
+		switch {
+		case img == nil || !inNew[sessionID]:
+			// 新文件已不存在，或移出了会话的目录
+			changed = sess.RemoveImageByPath(oldPath)
+		case inOld[sessionID]:
+			filterFunc := image.BuildImageFilter(sess.Filter())
+			changed = sess.RenameImage(oldPath, img, filterFunc(img))
+		default:
+			// 从其他目录移入
+			filterFunc := image.BuildImageFilter(sess.Filter())
+			changed = sess.UpdateImage(img, filterFunc(img))
+		}
+
+		if changed {
+			s.sessionSaved.Publish(ctx, sess.ID())
+		}
+
+		release()
+	}
+
+	return nil
+}
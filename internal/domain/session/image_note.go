@@ -0,0 +1,68 @@
+package session
+
+import (
+	"context"
+	"iter"
+	"main/internal/apperror"
+	"main/internal/domain/image"
+	"main/internal/scalar"
+	"time"
+)
+
+// #region Session Methods
+
+// SetImageNote 设置图片备注，提交时写入 XMP
+//
+// 空字符串表示清除备注，提交时同样会清除 XMP 中已有的备注
+func (s *Session) SetImageNote(imageID scalar.ID, note string) error {
+	if _, ok := s.indexByID[imageID]; !ok {
+		return apperror.NewErrDocumentNotFound(imageID)
+	}
+
+	s.notes[imageID] = note
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// ImageNote 返回会话中为图片设置的备注，第二个返回值表示是否设置过
+func (s *Session) ImageNote(imageID scalar.ID) (string, bool) {
+	note, ok := s.notes[imageID]
+	return note, ok
+}
+
+// Notes 返回所有设置了备注的图片
+//
+// 不按当前筛选条件过滤，评分变化或更换筛选条件后备注仍会在提交时写入
+func (s *Session) Notes() iter.Seq2[*image.Image, string] {
+	return func(yield func(*image.Image, string) bool) {
+		for idx, img := range s.images {
+			// 跳过 ID 变化前的旧版本
+			if s.indexByID[img.ID()] != idx {
+				continue
+			}
+			if note, ok := s.notes[img.ID()]; ok {
+				if !yield(img, note) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// #endregion
+
+// SetImageNote 设置图片备注并保存
+func (s *Service) SetImageNote(ctx context.Context, sessionID scalar.ID, imageID scalar.ID, note string) error {
+	sess, release, err := s.sessionRepo.Acquire(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if err := sess.SetImageNote(imageID, note); err != nil {
+		return err
+	}
+
+	s.sessionSaved.Publish(ctx, sess.ID())
+	return nil
+}
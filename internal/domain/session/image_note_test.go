@@ -0,0 +1,111 @@
+package session
+
+import (
+	"context"
+	"main/internal/domain/image"
+	"main/internal/domain/metadata"
+	"main/internal/scalar"
+	"main/internal/shared"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetImageNote_UnknownImage_ShouldReturnError(t *testing.T) {
+	session := setupTestSession(t, 3, 1)
+
+	err := session.SetImageNote(scalar.ToID("unknown"), "note")
+	assert.Error(t, err)
+}
+
+func TestSetImageNote_ShouldNotAdvanceQueue(t *testing.T) {
+	session := setupTestSession(t, 3, 1)
+	imgID := session.CurrentImage().ID()
+
+	require.NoError(t, session.SetImageNote(imgID, "fix left hand"))
+
+	note, ok := session.ImageNote(imgID)
+	assert.True(t, ok)
+	assert.Equal(t, "fix left hand", note)
+	assert.Equal(t, 0, session.CurrentIndex(), "Setting a note should not advance the queue")
+	assert.False(t, session.CanUndo(), "Setting a note should not be undoable like a mark")
+}
+
+func TestService_Commit_ShouldWriteNotes(t *testing.T) {
+	svc := setupTestService(t)
+	img1 := svc.AddImage(t, "test1.jpg", metadata.NewXMPData(0, "", time.Time{}))
+	img2 := svc.AddImage(t, "test2.jpg", metadata.NewXMPData(0, "shelve", time.Time{}))
+
+	filter := &shared.ImageFilters{Rating: []int{0}}
+	sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), filter, 10, []*image.Image{img1, img2})
+
+	require.NoError(t, sess.MarkImage(img1.ID(), shared.ImageActionKeep))
+	require.NoError(t, sess.SetImageNote(img1.ID(), "great composition"))
+	// 只有备注没有操作
+	require.NoError(t, sess.SetImageNote(img2.ID(), "fix left hand in inpaint"))
+
+	writeActions := &shared.WriteActions{
+		KeepRating:   5,
+		ShelveRating: 0,
+		RejectRating: -1,
+	}
+
+	success, errs := svc.Commit(context.Background(), sess, writeActions)
+	require.NoError(t, errs)
+	require.Equal(t, 2, success)
+
+	require.Equal(t, 5, svc.MetaRepo.Data[img1.Path()].Rating())
+	require.Equal(t, "great composition", svc.MetaRepo.Data[img1.Path()].Note())
+
+	require.Equal(t, 0, svc.MetaRepo.Data[img2.Path()].Rating(), "Note-only commit should keep the current rating")
+	require.Equal(t, "shelve", svc.MetaRepo.Data[img2.Path()].Action(), "Note-only commit should keep the current action")
+	require.Equal(t, "fix left hand in inpaint", svc.MetaRepo.Data[img2.Path()].Note())
+
+	inMemImg := sess.images[sess.indexByID[img2.ID()]]
+	require.Equal(t, "fix left hand in inpaint", inMemImg.Note(), "In-memory image note should be updated after commit")
+
+	// 再次提交时磁盘状态已符合目标，不应重复写入
+	success, errs = svc.Commit(context.Background(), sess, writeActions)
+	require.NoError(t, errs)
+	require.Equal(t, 0, success)
+}
+
+func TestService_Commit_WithoutNote_ShouldKeepExistingNote(t *testing.T) {
+	svc := setupTestService(t)
+	existing := metadata.NewXMPData(0, "", time.Time{}, metadata.WithNote("from another tool"))
+	img1 := svc.AddImage(t, "test1.jpg", existing)
+	svc.MetaRepo.Data[img1.Path()] = existing
+
+	sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), nil, 10, []*image.Image{img1})
+	require.NoError(t, sess.MarkImage(img1.ID(), shared.ImageActionKeep))
+
+	success, errs := svc.Commit(context.Background(), sess, &shared.WriteActions{KeepRating: 5})
+	require.NoError(t, errs)
+	require.Equal(t, 1, success)
+
+	assert.Equal(t, 5, svc.MetaRepo.Data[img1.Path()].Rating())
+	assert.Equal(t, "from another tool", svc.MetaRepo.Data[img1.Path()].Note(), "Commit without a session note should not touch the description")
+	assert.Equal(t, "from another tool", sess.images[sess.indexByID[img1.ID()]].Note())
+}
+
+func TestService_Commit_ShouldWriteNotesOutsideFilter(t *testing.T) {
+	svc := setupTestService(t)
+	existing := metadata.NewXMPData(3, "", time.Time{})
+	img1 := svc.AddImage(t, "test1.jpg", existing)
+	svc.MetaRepo.Data[img1.Path()] = existing
+
+	sess := NewSession(scalar.ToID("s1"), scalar.ToID("d1"), &shared.ImageFilters{Rating: []int{3}}, 10, []*image.Image{img1})
+	require.NoError(t, sess.SetImageNote(img1.ID(), "fix left hand"))
+
+	// 更换筛选条件后图片不再符合
+	sess.filter = &shared.ImageFilters{Rating: []int{0}}
+
+	success, errs := svc.Commit(context.Background(), sess, &shared.WriteActions{KeepRating: 5})
+	require.NoError(t, errs)
+	require.Equal(t, 1, success)
+
+	assert.Equal(t, "fix left hand", svc.MetaRepo.Data[img1.Path()].Note(), "Note should be written even if the image no longer matches the filter")
+	assert.Equal(t, 3, svc.MetaRepo.Data[img1.Path()].Rating())
+}
@@ -0,0 +1,110 @@
+package session
+
+import (
+	"context"
+	"main/internal/apperror"
+	"main/internal/domain/image"
+	"main/internal/domain/metadata"
+	"main/internal/scalar"
+	"time"
+)
+
+var ErrVideoOrientation = apperror.New("INVALID_OPERATION", "video orientation is not supported", "视频不支持设置方向")
+
+// OrientationWriter 将方向写入图片的 sidecar，返回写入后的图片
+type OrientationWriter func(img *image.Image, orientation int) (*image.Image, error)
+
+// #region Session Methods
+
+// SetImageOrientation 设置图片方向，立即通过 write 写入 sidecar
+//
+// orientation 为 0 表示清除 sidecar 中的方向，恢复使用原图中的方向。
+// 与标记不同，方向不等待提交，撤销时同样通过 write 写回之前的值
+func (s *Session) SetImageOrientation(imageID scalar.ID, orientation int, write OrientationWriter) error {
+	idx, ok := s.indexByID[imageID]
+	if !ok {
+		return apperror.NewErrDocumentNotFound(imageID)
+	}
+	if orientation != 0 && !image.IsValidOrientation(orientation) {
+		return image.ErrInvalidOrientation
+	}
+	img := s.images[idx]
+	if img.IsVideo() {
+		return ErrVideoOrientation
+	}
+
+	prev := img.XMPData().Orientation()
+	if prev == orientation {
+		return nil
+	}
+	if err := s.writeOrientation(idx, orientation, write); err != nil {
+		return err
+	}
+
+	s.undoStack = append(s.undoStack, func() error {
+		// 撤销前图片可能已被文件监听替换，按 ID 重新查找
+		idx, ok := s.indexByID[imageID]
+		if !ok {
+			return apperror.NewErrDocumentNotFound(imageID)
+		}
+		return s.writeOrientation(idx, prev, write)
+	})
+	return nil
+}
+
+func (s *Session) writeOrientation(idx int, orientation int, write OrientationWriter) error {
+	img, err := write(s.images[idx], orientation)
+	if err != nil {
+		return err
+	}
+	s.images[idx] = img
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// #endregion
+
+// SetImageOrientation 设置图片方向并保存，方向以非破坏的方式写入 sidecar 的 tiff:Orientation
+func (s *Service) SetImageOrientation(ctx context.Context, sessionID scalar.ID, imageID scalar.ID, orientation int) error {
+	sess, release, err := s.sessionRepo.Acquire(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	write := func(img *image.Image, orientation int) (*image.Image, error) {
+		return s.writeOrientation(sess, img, orientation)
+	}
+	if err := sess.SetImageOrientation(imageID, orientation, write); err != nil {
+		return err
+	}
+
+	s.sessionSaved.Publish(ctx, sess.ID())
+	return nil
+}
+
+// writeOrientation 写入方向，保留 sidecar 中已有的评分和操作
+func (s *Service) writeOrientation(session *Session, img *image.Image, orientation int) (*image.Image, error) {
+	xmpData := metadata.NewXMPData(
+		img.Rating(),
+		img.XMPData().Action(),
+		time.Now(),
+		metadata.WithOrientation(orientation),
+		metadata.WithSessionID(session.ID().String()),
+	)
+	// sidecar 在会话加载图片后被外部修改时拒绝写入，避免覆盖其他工具的评分
+	err := s.metadataRepo.Write(img.Path(), xmpData,
+		metadata.WithExpectedRevision(img.XMPRevision()),
+		metadata.WithHistoryAction("ORIENT"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 重新读取写入结果，以获得新的修订版本和追加的编辑历史
+	written, err := s.metadataRepo.Read(img.Path())
+	if err != nil {
+		return nil, err
+	}
+	return img.WithXMPData(written), nil
+}
@@ -0,0 +1,101 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"main/internal/domain/image"
+	"main/internal/pubsub"
+	"main/internal/scalar"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSetImageOrientation_InvalidOrientation_ShouldReturnError(t *testing.T) {
+	session := setupTestSession(t, 3, 1)
+	imgID := session.CurrentImage().ID()
+
+	err := session.SetImageOrientation(imgID, 9, func(img *image.Image, orientation int) (*image.Image, error) {
+		t.Fatal("write should not be called")
+		return nil, nil
+	})
+	assert.ErrorIs(t, err, image.ErrInvalidOrientation)
+	assert.False(t, session.CanUndo())
+}
+
+func TestSetImageOrientation_WriteFailed_ShouldNotPushUndo(t *testing.T) {
+	session := setupTestSession(t, 3, 1)
+	imgID := session.CurrentImage().ID()
+
+	writeErr := errors.New("disk full")
+	err := session.SetImageOrientation(imgID, 6, func(img *image.Image, orientation int) (*image.Image, error) {
+		return nil, writeErr
+	})
+	assert.ErrorIs(t, err, writeErr)
+	assert.False(t, session.CanUndo())
+	assert.Equal(t, 1920, session.CurrentImage().Width())
+}
+
+func TestService_SetImageOrientation_ShouldWriteSidecarAndUndo(t *testing.T) {
+	fakeMeta := NewFakeMetadataRepo()
+	fakeSessionRepo := NewFakeSessionRepo()
+	topic, cleanup := pubsub.NewInMemoryTopic[scalar.ID]()
+	defer cleanup()
+
+	service, stop := NewService(fakeSessionRepo, fakeMeta, &FakeScanner{}, &FakeEventBus{}, zap.NewNop(), topic, newLoadProgressTopic(t), "/test")
+	defer stop()
+
+	session := setupTestSession(t, 3, 1)
+	_, err := fakeSessionRepo.Create(session)
+	require.NoError(t, err)
+	img := session.CurrentImage()
+	ctx := context.Background()
+
+	// 旋转 90 度后宽高交换，不推进队列
+	require.NoError(t, service.SetImageOrientation(ctx, session.ID(), img.ID(), 6))
+	assert.Equal(t, 6, fakeMeta.Data[img.Path()].Orientation())
+	rotated := session.CurrentImage()
+	assert.Equal(t, img.ID(), rotated.ID())
+	assert.Equal(t, 6, rotated.Orientation())
+	assert.Equal(t, 1080, rotated.Width())
+	assert.Equal(t, 1920, rotated.Height())
+	assert.Equal(t, 0, session.CurrentIndex())
+
+	require.NoError(t, service.SetImageOrientation(ctx, session.ID(), img.ID(), 3))
+	assert.Equal(t, 1920, session.CurrentImage().Width())
+
+	// 撤销依次写回之前的方向
+	require.NoError(t, service.Undo(ctx, session.ID()))
+	assert.Equal(t, 6, fakeMeta.Data[img.Path()].Orientation())
+	assert.Equal(t, 1080, session.CurrentImage().Width())
+
+	require.NoError(t, service.Undo(ctx, session.ID()))
+	assert.Equal(t, 0, fakeMeta.Data[img.Path()].Orientation())
+	assert.Equal(t, 1, session.CurrentImage().Orientation())
+	assert.Equal(t, 1920, session.CurrentImage().Width())
+	assert.False(t, session.CanUndo())
+}
+
+func TestService_SetImageOrientation_ExternalChange_ShouldReturnConflict(t *testing.T) {
+	fakeMeta := NewFakeMetadataRepo()
+	fakeSessionRepo := NewFakeSessionRepo()
+	topic, cleanup := pubsub.NewInMemoryTopic[scalar.ID]()
+	defer cleanup()
+
+	service, stop := NewService(fakeSessionRepo, fakeMeta, &FakeScanner{}, &FakeEventBus{}, zap.NewNop(), topic, newLoadProgressTopic(t), "/test")
+	defer stop()
+
+	session := setupTestSession(t, 3, 1)
+	_, err := fakeSessionRepo.Create(session)
+	require.NoError(t, err)
+	img := session.CurrentImage()
+
+	// 会话加载后 sidecar 被其他工具写入
+	require.NoError(t, fakeMeta.Write(img.Path(), img.XMPData()))
+
+	err = service.SetImageOrientation(context.Background(), session.ID(), img.ID(), 6)
+	assert.Error(t, err)
+	assert.False(t, session.CanUndo())
+}
@@ -2,6 +2,8 @@ package session
 
 import (
 	"main/internal/domain/image"
+	"main/internal/scalar"
+	"slices"
 	"time"
 )
 
@@ -45,7 +47,8 @@ func (s *Session) UpdateImage(img *image.Image, matchesFilter bool) bool {
 	// 如果 ID 没变，直接更新对象内容
 	oldImg := s.images[oldImageIndex]
 	if oldImg.ID() == img.ID() {
-		s.images[oldImageIndex] = img
+		s.forgetRemoved(oldImageIndex)
+		s.refreshImage(oldImageIndex, img)
 		// queue 中的引用是 index，不需要变
 	} else {
 		// ID 变了 (如修改时间变化)，视为新图片
@@ -55,11 +58,8 @@ func (s *Session) UpdateImage(img *image.Image, matchesFilter bool) bool {
 		s.indexByID[img.ID()] = newImageIndex
 		s.indexByPath[img.Path()] = newImageIndex
 
-		// 迁移 action
-		if action, ok := s.actions[oldImg.ID()]; ok {
-			s.actions[img.ID()] = action
-			delete(s.actions, oldImg.ID())
-		}
+		s.keepXMPBaseline(oldImg, img)
+		s.migrateDecision(oldImg.ID(), img.ID())
 
 		// 更新 queue 指向新图片
 		if oldQueueIndex != -1 {
@@ -71,8 +71,124 @@ func (s *Session) UpdateImage(img *image.Image, matchesFilter bool) bool {
 	return true
 }
 
+// RenameImage 将重命名或移动前位于 oldPath 的图片迁移到新路径，保留用户的操作记录
+//
+// 新路径不符合过滤器时按删除处理；旧路径不在会话中时按新增处理
+func (s *Session) RenameImage(oldPath string, img *image.Image, matchesFilter bool) bool {
+	idx, ok := s.indexByPath[oldPath]
+	if !ok || s.images[idx].Path() != oldPath {
+		return s.UpdateImage(img, matchesFilter)
+	}
+	if !matchesFilter {
+		return s.RemoveImageByPath(oldPath)
+	}
+	// 新路径上已有图片时（如覆盖），旧图片按删除处理
+	if existing, ok := s.indexByPath[img.Path()]; ok && existing != idx {
+		s.RemoveImageByPath(oldPath)
+		return s.UpdateImage(img, matchesFilter)
+	}
+
+	if s.images[idx].ID() == img.ID() {
+		s.moveImage(idx, img)
+		return true
+	}
+	s.relocateImage(idx, img)
+	return true
+}
+
+// relocateImage 将 images 中 idx 处的图片替换为 ID 不同的新路径上的图片
+//
+// 新图片追加到 images，操作记录随 ID 迁移，并替换队列中的旧图片
+func (s *Session) relocateImage(idx int, img *image.Image) {
+	oldImg := s.images[idx]
+	s.forgetRemoved(idx)
+
+	newIdx := len(s.images)
+	s.images = append(s.images, img)
+	s.indexByID[img.ID()] = newIdx
+	if s.indexByPath[oldImg.Path()] == idx {
+		delete(s.indexByPath, oldImg.Path())
+	}
+	s.indexByPath[img.Path()] = newIdx
+	s.keepXMPBaseline(oldImg, img)
+	s.migrateDecision(oldImg.ID(), img.ID())
+
+	if i := slices.Index(s.queue, idx); i != -1 {
+		s.queue[i] = newIdx
+	} else if _, hasAction := s.actions[img.ID()]; !hasAction {
+		// 未处理的图片在旧文件删除时被移出了队列，重新加入
+		s.queue = append(s.queue, newIdx)
+	}
+
+	s.updatedAt = time.Now()
+}
+
+// forgetRemoved 清除 images 中 idx 处图片的删除记录
+func (s *Session) forgetRemoved(idx int) {
+	if fingerprint := s.images[idx].Fingerprint(); fingerprint != "" && s.removed[fingerprint] == idx {
+		delete(s.removed, fingerprint)
+	}
+}
+
+// refreshImage 用重新读取的图片替换 images 中 idx 处 ID 相同的图片
+func (s *Session) refreshImage(idx int, img *image.Image) {
+	s.keepXMPBaseline(s.images[idx], img)
+	s.images[idx] = img
+}
+
+// keepXMPBaseline 在图片被重新读取的版本替换前，保留操作和备注所基于的 XMP 数据
+//
+// 会话中显示最新的图片，提交时以基准的修订版本检查冲突，
+// 因此外部工具（如 Lightroom）在用户操作后对 sidecar 的修改不会被静默覆盖
+func (s *Session) keepXMPBaseline(old, img *image.Image) {
+	_, hasAction := s.actions[old.ID()]
+	_, hasNote := s.notes[old.ID()]
+	if !hasAction && !hasNote {
+		delete(s.xmpBaselines, old.ID())
+		return
+	}
+	if _, ok := s.xmpBaselines[old.ID()]; !ok && old.XMPRevision() != img.XMPRevision() {
+		s.xmpBaselines[old.ID()] = old
+	}
+}
+
+// xmpBaseline 返回提交图片时检查冲突的基准，即用户操作时看到的版本
+func (s *Session) xmpBaseline(img *image.Image) *image.Image {
+	if baseline, ok := s.xmpBaselines[img.ID()]; ok {
+		return baseline
+	}
+	return img
+}
+
+// migrateDecision 将按 ID 记录的操作、耗时、备注和提交基准迁移到新的 ID
+func (s *Session) migrateDecision(from, to scalar.ID) {
+	if action, ok := s.actions[from]; ok {
+		s.actions[to] = action
+		delete(s.actions, from)
+	}
+	if duration, ok := s.durations[from]; ok {
+		s.durations[to] = duration
+		delete(s.durations, from)
+	}
+	if note, ok := s.notes[from]; ok {
+		s.notes[to] = note
+		delete(s.notes, from)
+	}
+	if baseline, ok := s.xmpBaselines[from]; ok {
+		s.xmpBaselines[to] = baseline
+		delete(s.xmpBaselines, from)
+	}
+}
+
 // RemoveImageByPath 根据路径从会话中移除图片
 func (s *Session) RemoveImageByPath(path string) bool {
+	// 使用内容标识时记录删除的文件，之后出现相同内容的新文件时视为移动
+	if idx, ok := s.indexByPath[path]; ok && s.images[idx].Path() == path {
+		if fingerprint := s.images[idx].Fingerprint(); fingerprint != "" {
+			s.removed[fingerprint] = idx
+		}
+	}
+
 	var targetIndex = -1
 	var targetImgIndex = -1
 
@@ -113,10 +229,18 @@ func (s *Session) addFilteredImage(img *image.Image) error {
 		// 只是更新引用，不添加到队列
 		// 如果它已经存在但不在队列中，说明它已经被处理过（保留/排除/搁置）
 		// 我们保留这个决定，不重新将其加入队列
-		s.images[idx] = img
+		s.refreshImage(idx, img)
 		return nil
 	}
 
+	// 相同内容的文件刚被删除，视为移动；否则（如副本）作为新图片
+	if fingerprint := img.Fingerprint(); fingerprint != "" {
+		if idx, ok := s.removed[fingerprint]; ok {
+			s.relocateImage(idx, img)
+			return nil
+		}
+	}
+
 	// 新增
 	newIdx := len(s.images)
 	s.images = append(s.images, img)
@@ -128,3 +252,20 @@ func (s *Session) addFilteredImage(img *image.Image) error {
 
 	return nil
 }
+
+// moveImage 将图片迁移到新路径，操作、耗时和备注按 ID 记录，随图片迁移
+func (s *Session) moveImage(idx int, img *image.Image) {
+	oldPath := s.images[idx].Path()
+	if s.indexByPath[oldPath] == idx {
+		delete(s.indexByPath, oldPath)
+	}
+	s.indexByPath[img.Path()] = idx
+	s.forgetRemoved(idx)
+	s.refreshImage(idx, img)
+
+	// 未处理的图片在旧文件删除时被移出了队列，重新加入
+	if _, hasAction := s.actions[img.ID()]; !hasAction && !slices.Contains(s.queue, idx) {
+		s.queue = append(s.queue, idx)
+	}
+	s.updatedAt = time.Now()
+}
@@ -1,6 +1,7 @@
 package session
 
 import (
+	"fmt"
 	"main/internal/domain/image"
 	"main/internal/domain/metadata"
 	"main/internal/scalar"
@@ -101,3 +102,108 @@ func TestUpdateImage_ShouldNotRemoveMarkedImageWhenFilterChanges(t *testing.T) {
 	assert.Equal(t, 0, stats.Remaining, "图片已处理完，Remaining 应为 0")
 	assert.Equal(t, 1, stats.Kept, "Kept 计数应保留（图片仍在 images 中）")
 }
+
+// setupFingerprintSession 创建使用内容标识的会话，每张图片具有不同的指纹
+func setupFingerprintSession(n int) *Session {
+	images := make([]*image.Image, n)
+	for i, img := range createTestImages(n) {
+		images[i] = image.NewImage(img.ID(), img.Filename(), img.Path(), img.Size(), img.ModTime(), img.XMPData(), img.Width(), img.Height(),
+			image.WithFingerprint(fmt.Sprintf("fp-%d", i)))
+	}
+	return NewSession(scalar.ToID("test-id"), scalar.ToID("test-dir-id"), &shared.ImageFilters{Rating: []int{0}}, 5, images)
+}
+
+// movedImage 返回内容相同（指纹相同）但路径和 ID 不同的图片，模拟使用内容标识时的重命名或副本
+func movedImage(img *image.Image, path string) *image.Image {
+	return image.NewImage(scalar.ToID("moved:"+path), "moved.jpg", path, img.Size(), img.ModTime(), img.XMPData(), img.Width(), img.Height(),
+		image.WithFingerprint(img.Fingerprint()))
+}
+
+func TestUpdateImage_RemoveThenCreate_ShouldMoveImage(t *testing.T) {
+	session := setupFingerprintSession(3)
+	img0 := session.images[session.queue[0]]
+	img1 := session.images[session.queue[1]]
+	require.NoError(t, session.MarkImage(img0.ID(), shared.ImageActionKeep))
+
+	// 已操作的图片保留决定，操作记录迁移到新 ID
+	session.RemoveImageByPath(img0.Path())
+	moved0 := movedImage(img0, "/test/moved-0.jpg")
+	assert.True(t, session.UpdateImage(moved0, true))
+	assert.Equal(t, shared.ImageActionKeep, ActionOf(session, moved0.ID()))
+	assert.Equal(t, "/test/moved-0.jpg", session.images[session.queue[0]].Path())
+	assert.NotContains(t, session.indexByPath, img0.Path())
+
+	// 未操作的图片删除时移出队列，移动后重新加入
+	assert.True(t, session.RemoveImageByPath(img1.Path()))
+	assert.Equal(t, 2, session.CurrentSize())
+	assert.True(t, session.UpdateImage(movedImage(img1, "/test/moved-1.jpg"), true))
+	assert.Equal(t, 3, session.CurrentSize())
+	assert.Equal(t, "/test/moved-1.jpg", session.images[session.queue[2]].Path())
+	assert.Empty(t, session.removed)
+
+	// 旧路径不再指向图片
+	assert.False(t, session.RemoveImageByPath(img1.Path()))
+	assert.Equal(t, 3, session.CurrentSize())
+}
+
+func TestUpdateImage_CreateThenRemove_ShouldKeepCopy(t *testing.T) {
+	session := setupFingerprintSession(3)
+	img0 := session.images[session.queue[0]]
+
+	// 旧文件仍存在时内容相同的文件是副本，作为不同的图片
+	copied := movedImage(img0, "/test/copy-0.jpg")
+	assert.True(t, session.UpdateImage(copied, true))
+	assert.Equal(t, 4, session.CurrentSize())
+	assert.NotEqual(t, img0.ID(), copied.ID())
+
+	// 删除原文件后副本仍在队列中
+	assert.True(t, session.RemoveImageByPath(img0.Path()))
+	assert.Equal(t, 3, session.CurrentSize())
+	assert.Equal(t, "/test/copy-0.jpg", session.images[session.queue[2]].Path())
+}
+
+func TestRemoveImageByPath_ShouldOnlyRecordFingerprintedImages(t *testing.T) {
+	session := setupTestSession(t, 3, 5)
+	assert.True(t, session.RemoveImageByPath(session.CurrentImage().Path()))
+	assert.Empty(t, session.removed)
+}
+
+func TestNextRound_ShouldForgetRemovedImages(t *testing.T) {
+	session := setupFingerprintSession(3)
+	img0 := session.images[session.queue[0]]
+	img1 := session.images[session.queue[1]]
+	require.NoError(t, session.MarkImage(img0.ID(), shared.ImageActionKeep))
+
+	assert.True(t, session.RemoveImageByPath(img1.Path()))
+	require.Len(t, session.removed, 1)
+
+	require.NoError(t, session.NextRound(nil, []*image.Image{img0}))
+	assert.Empty(t, session.removed)
+
+	// 新一轮之后出现相同内容的文件作为新图片
+	moved1 := movedImage(img1, "/test/moved-1.jpg")
+	assert.True(t, session.UpdateImage(moved1, true))
+	assert.Equal(t, 2, session.CurrentSize())
+	assert.Equal(t, "/test/moved-1.jpg", session.images[session.queue[1]].Path())
+}
+
+func TestRenameImage_ShouldKeepDecision(t *testing.T) {
+	session := setupTestSession(t, 3, 5)
+	img0 := session.images[session.queue[0]]
+	img1 := session.images[session.queue[1]]
+	require.NoError(t, session.MarkImage(img0.ID(), shared.ImageActionKeep))
+
+	// ID 随路径变化，操作记录迁移到新 ID
+	renamed := image.NewImage(scalar.ToID("renamed-0"), "renamed.jpg", "/test/renamed-0.jpg", img0.Size(), img0.ModTime(), img0.XMPData(), img0.Width(), img0.Height())
+	assert.True(t, session.RenameImage(img0.Path(), renamed, true))
+	assert.Equal(t, shared.ImageActionKeep, ActionOf(session, renamed.ID()))
+	assert.Equal(t, "/test/renamed-0.jpg", session.images[session.queue[0]].Path())
+	assert.Equal(t, 3, session.CurrentSize())
+	assert.False(t, session.RemoveImageByPath(img0.Path()), "旧路径不再指向图片")
+
+	// ID 不变时直接迁移
+	sameID := image.NewImage(img1.ID(), "renamed.jpg", "/test/renamed-1.jpg", img1.Size(), img1.ModTime(), img1.XMPData(), img1.Width(), img1.Height())
+	assert.True(t, session.RenameImage(img1.Path(), sameID, true))
+	assert.Equal(t, "/test/renamed-1.jpg", session.CurrentImage().Path())
+	assert.Equal(t, 3, session.CurrentSize())
+}
@@ -34,7 +34,7 @@ func (s *Session) MarkImage(imageID scalar.ID, action shared.ImageAction, option
 	// 记录撤销操作
 	prevAction, hasPrevAction := s.actions[imageID]
 	var previousIndex = s.currentIdx
-	s.undoStack = append(s.undoStack, func() {
+	s.undoStack = append(s.undoStack, func() error {
 		// 恢复操作状态
 		if !hasPrevAction {
 			delete(s.actions, imageID)
@@ -48,6 +48,7 @@ func (s *Session) MarkImage(imageID scalar.ID, action shared.ImageAction, option
 			s.currentIdx = previousIndex
 		}
 		s.updatedAt = time.Now()
+		return nil
 	})
 
 	s.actions[imageID] = action
@@ -65,24 +66,32 @@ func (s *Session) MarkImage(imageID scalar.ID, action shared.ImageAction, option
 	// 已在队列末尾时（含刚推进 OR 乱序标记时已越界），检查是否需要开启新一轮。
 	// 乱序标记已越界的情况：用户在"刚完成"状态下回头改变某张图片为 Keep，
 	// 使 Kept > targetKeep，此时必须触发 NextRound，否则 currentImage 将永远为 null。
-	if s.currentIdx >= len(s.queue) {
-		stats := s.Stats()
-
-		if stats.Kept > s.targetKeep {
-			var newQueue []*image.Image
-			for _, idx := range s.queue {
-				img := s.images[idx]
-				action := s.actions[img.ID()]
-				if action == shared.ImageActionKeep {
-					newQueue = append(newQueue, img)
-				}
-			}
+	return s.checkRoundEnd()
+}
 
-			// 开启新一轮
-			if err := s.NextRound(nil, newQueue); err != nil {
-				return err
+// checkRoundEnd 在队列处理完成且保留数量超过目标时开启新一轮
+//
+// 加载中的会话后续还会加入图片，等待加载结束后再检查
+func (s *Session) checkRoundEnd() error {
+	if s.currentIdx < len(s.queue) || s.Loading() {
+		return nil
+	}
+
+	stats := s.Stats()
+	if stats.Kept > s.targetKeep {
+		var newQueue []*image.Image
+		for _, idx := range s.queue {
+			img := s.images[idx]
+			action := s.actions[img.ID()]
+			if action == shared.ImageActionKeep {
+				newQueue = append(newQueue, img)
 			}
 		}
+
+		// 开启新一轮
+		if err := s.NextRound(nil, newQueue); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	"main/internal/pubsub"
 	"main/internal/scalar"
 	"main/internal/shared"
+	"sync"
 
 	"go.uber.org/zap"
 )
@@ -25,7 +26,14 @@ type Service struct {
 	logger       *zap.Logger
 	// 只发布 ID，订阅者需要自己 Acquire 后读取，避免跨 goroutine 持有 *Session 指针导致并发 map 读写
 	sessionSaved pubsub.Topic[scalar.ID]
+	// loadProgress 发布加载进度，加载期间不必每批都发布整个会话
+	loadProgress pubsub.Topic[LoadProgressEvent]
 	rootDir      string
+
+	// ctx 在服务停止时取消，用于后台加载会话
+	ctx       context.Context
+	loadersMu sync.Mutex
+	loaders   map[scalar.ID]*loader // 正在加载的会话
 }
 
 func NewService(
@@ -35,6 +43,7 @@ func NewService(
 	eventBus EventBus,
 	logger *zap.Logger,
 	sessionSaved pubsub.Topic[scalar.ID],
+	loadProgress pubsub.Topic[LoadProgressEvent],
 	rootDir string,
 ) (*Service, func()) {
 	s := &Service{
@@ -44,10 +53,13 @@ func NewService(
 		eventBus:     eventBus,
 		logger:       logger,
 		sessionSaved: sessionSaved,
+		loadProgress: loadProgress,
 		rootDir:      rootDir,
+		loaders:      make(map[scalar.ID]*loader),
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
+	s.ctx = ctx
 	cleanup := func() {
 		cancel()
 	}
@@ -31,11 +31,19 @@ type Session struct {
 	queue       []int             // 待处理队列（存储 images 索引）
 
 	currentIdx int                              // 当前处理的图片在队列中的索引
-	undoStack  []func()                         // 撤销操作栈
+	undoStack  []func() error                   // 撤销操作栈
 	actions    map[scalar.ID]shared.ImageAction // 图片操作映射
 	durations  map[scalar.ID]scalar.Duration    // 图片操作耗时映射
+	notes      map[scalar.ID]string             // 图片备注映射（提交时写入 XMP）
+
+	xmpBaselines map[scalar.ID]*image.Image // 操作后 sidecar 被外部修改的图片，保留操作时的版本作为提交基准
 
 	currentRound int // 当前筛选轮次
+
+	// 使用内容标识时，本轮中文件已被删除的图片（指纹 -> images 索引），用于将删除和之后的创建关联为移动
+	removed map[string]int
+
+	loadProgress LoadProgress // 扫描目录的进度
 }
 
 // NewSession 创建一个新的图片筛选会话
@@ -70,10 +78,18 @@ func NewSession(id scalar.ID, directoryID scalar.ID, filter *shared.ImageFilters
 		indexByPath:  indexByPath,
 		queue:        queue,
 		currentIdx:   0,
-		undoStack:    make([]func(), 0),
+		undoStack:    make([]func() error, 0),
 		actions:      actions,
 		durations:    make(map[scalar.ID]scalar.Duration),
+		notes:        make(map[scalar.ID]string),
+		xmpBaselines: make(map[scalar.ID]*image.Image),
 		currentRound: 0,
+		removed:      make(map[string]int),
+		loadProgress: LoadProgress{
+			State:          shared.SessionLoadStateReady,
+			FilesSeen:      len(images),
+			ImagesAccepted: len(images),
+		},
 	}
 }
 
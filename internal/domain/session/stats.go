@@ -44,8 +44,9 @@ func (s *Session) Stats() *shared.StatsDTO {
 	// 会话完成条件：
 	// 1. 所有图片都已处理 (remaining == 0)
 	// 2. 且保留的图片数量不超过目标保留数量 (否则需要开启新一轮)
+	// 3. 且已加载完成，加载中的会话后续还会加入图片
 	// 注意：搁置 (Shelve) 的图片不计入目标保留数量计算，因为它们在本会话中被视为已丢弃
-	stats.IsCompleted = stats.Remaining == 0 && (stats.Kept <= s.targetKeep)
+	stats.IsCompleted = stats.Remaining == 0 && (stats.Kept <= s.targetKeep) && !s.Loading()
 
 	return &stats
 }
@@ -8,15 +8,18 @@ import (
 	"main/internal/domain/directory"
 	"main/internal/domain/image"
 	"main/internal/domain/metadata"
+	"main/internal/pubsub"
 	"main/internal/scalar"
 	"main/internal/shared"
 	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 // #region Helper Functions
@@ -87,13 +90,56 @@ func markImagesInSession(t *testing.T, session *Session, actionFn func(index int
 	}
 }
 
+// newLoadProgressTopic creates a load progress topic disposed with the test.
+func newLoadProgressTopic(t *testing.T) *pubsub.InMemoryTopic[LoadProgressEvent] {
+	topic, cleanup := pubsub.NewInMemoryTopic[LoadProgressEvent]()
+	t.Cleanup(cleanup)
+	return topic
+}
+
+// testService is a session service backed by fakes rooted at a temporary directory.
+type testService struct {
+	*Service
+	Dir      string
+	Repo     *FakeSessionRepo
+	MetaRepo *FakeMetadataRepo
+	Scanner  *FakeScanner
+}
+
+// setupTestService creates a session service backed by fakes, disposed with the test.
+func setupTestService(t *testing.T) *testService {
+	dir := t.TempDir()
+	repo := NewFakeSessionRepo()
+	metaRepo := NewFakeMetadataRepo()
+	scanner := &FakeScanner{
+		MetaRepo: metaRepo,
+		BaseDir:  dir,
+		Images:   make(map[string]*image.Image),
+	}
+	topic, cleanupTopic := pubsub.NewInMemoryTopic[scalar.ID]()
+	t.Cleanup(cleanupTopic)
+	svc, cleanup := NewService(repo, metaRepo, scanner, &FakeEventBus{}, zap.NewNop(), topic, newLoadProgressTopic(t), dir)
+	t.Cleanup(cleanup)
+	return &testService{Service: svc, Dir: dir, Repo: repo, MetaRepo: metaRepo, Scanner: scanner}
+}
+
+// AddImage creates a file named name in the service directory and registers its image with the scanner.
+func (s *testService) AddImage(t *testing.T, name string, xmpData *metadata.XMPData, options ...image.ImageOption) *image.Image {
+	path := filepath.Join(s.Dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("fake"), 0644))
+	img := image.NewImage(scalar.ToID(name), name, path, 100, time.Now(), xmpData, 100, 100, options...)
+	s.Scanner.Images[name] = img
+	return img
+}
+
 // #endregion
 
 // #region Fakes
 
 // FakeMetadataRepo is a mock implementation of MetadataRepository.
 type FakeMetadataRepo struct {
-	Data map[string]*metadata.XMPData
+	Data      map[string]*metadata.XMPData
+	revisions int
 }
 
 func NewFakeMetadataRepo() *FakeMetadataRepo {
@@ -102,8 +148,29 @@ func NewFakeMetadataRepo() *FakeMetadataRepo {
 	}
 }
 
-func (f *FakeMetadataRepo) Write(path string, data *metadata.XMPData) error {
-	f.Data[path] = data
+func (f *FakeMetadataRepo) Write(path string, data *metadata.XMPData, options ...metadata.WriteOption) error {
+	existing := f.Data[path]
+	if expected, ok := metadata.NewWriteOptions(options...).ExpectedRevision(); ok && expected != existing.Revision() {
+		return metadata.NewErrXMPConflict(path)
+	}
+
+	// 和 xmpsidecar 一样，每次写入产生新的修订版本，未设置备注、方向时保留已有值
+	f.revisions++
+	dataOptions := []metadata.XMPDataOption{
+		metadata.WithSessionID(data.SessionID()),
+		metadata.WithRevision(strconv.Itoa(f.revisions)),
+	}
+	if data.HasNote() {
+		dataOptions = append(dataOptions, metadata.WithNote(data.Note()))
+	} else if existing.HasNote() {
+		dataOptions = append(dataOptions, metadata.WithNote(existing.Note()))
+	}
+	if data.HasOrientation() {
+		dataOptions = append(dataOptions, metadata.WithOrientation(data.Orientation()))
+	} else if existing.HasOrientation() {
+		dataOptions = append(dataOptions, metadata.WithOrientation(existing.Orientation()))
+	}
+	f.Data[path] = metadata.NewXMPData(data.Rating(), data.Action(), data.Timestamp(), dataOptions...)
 	return nil
 }
 
@@ -114,6 +181,10 @@ func (f *FakeMetadataRepo) Read(path string) (*metadata.XMPData, error) {
 	return nil, nil
 }
 
+func (f *FakeMetadataRepo) Revision(path string) (string, error) {
+	return f.Data[path].Revision(), nil
+}
+
 // FakeSessionRepo is a mock implementation of Repository.
 type FakeSessionRepo struct {
 	Sessions map[scalar.ID]*Session
@@ -168,10 +239,26 @@ type FakeScanner struct {
 	MetaRepo *FakeMetadataRepo
 	BaseDir  string
 	Images   map[string]*image.Image // RelPath -> Image
+
+	ScanImages []*image.Image // Images returned by Scan
+	ScanGate   chan struct{}  // If set, Scan waits for a value before yielding each image
 }
 
 func (s *FakeScanner) Scan(ctx context.Context, relPath string) iter.Seq2[*image.Image, error] {
-	return func(yield func(*image.Image, error) bool) {}
+	return func(yield func(*image.Image, error) bool) {
+		for _, img := range s.ScanImages {
+			if s.ScanGate != nil {
+				select {
+				case <-s.ScanGate:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !yield(img, nil) {
+				return
+			}
+		}
+	}
 }
 
 func (s *FakeScanner) LookupImage(ctx context.Context, relPath string) (*image.Image, error) {
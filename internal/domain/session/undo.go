@@ -15,7 +15,9 @@ func (s *Session) CanUndo() bool {
 	return len(s.undoStack) > 0
 }
 
-// Undo 撤销上一次图片标记操作，恢复到之前的状态
+// Undo 撤销上一次操作，恢复到之前的状态
+//
+// 撤销失败时（如写入 sidecar 失败）操作保留在撤销栈中，可以重试
 func (s *Session) Undo() error {
 	if len(s.undoStack) == 0 {
 		return ErrNothingToUndo
@@ -24,7 +26,10 @@ func (s *Session) Undo() error {
 	// 执行撤销函数
 	lastFunc := s.undoStack[len(s.undoStack)-1]
 	s.undoStack = s.undoStack[:len(s.undoStack)-1]
-	lastFunc()
+	if err := lastFunc(); err != nil {
+		s.undoStack = append(s.undoStack, lastFunc)
+		return err
+	}
 
 	return nil
 }
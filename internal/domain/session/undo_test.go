@@ -1,6 +1,7 @@
 package session
 
 import (
+	"errors"
 	"main/internal/domain/image"
 	"main/internal/shared"
 	"testing"
@@ -270,3 +271,38 @@ func TestUndo_ShouldRestoreFilter_WhenUndoNextRound(t *testing.T) {
 
 	assert.Equal(t, initialFilter, session.Filter(), "Filter should be restored to initial filter")
 }
+
+func TestUndo_ShouldKeepPreviousUndo_WhenUndoNextRoundFails(t *testing.T) {
+	session := setupTestSession(t, 2, 2)
+	markImagesInSession(t, session, func(int) shared.ImageAction { return shared.ImageActionKeep })
+
+	// 模拟上一轮最后一步撤销（例如写入 sidecar）失败
+	errUndo := errors.New("undo failed")
+	failing := true
+	calls := 0
+	session.undoStack = append(session.undoStack, func() error {
+		calls++
+		if failing {
+			return errUndo
+		}
+		return nil
+	})
+	stackLen := len(session.undoStack)
+
+	err := session.NextRound(nil, []*image.Image{session.images[0]})
+	require.NoError(t, err)
+
+	err = session.Undo()
+	assert.ErrorIs(t, err, errUndo)
+	assert.True(t, session.CanUndo())
+	assert.Equal(t, stackLen+1, len(session.undoStack), "Failed undo should stay on the stack")
+	assert.Equal(t, 1, session.currentRound, "Should stay in the new round when undo fails")
+	assert.Equal(t, 0, session.currentIdx)
+
+	failing = false
+	err = session.Undo()
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls, "Should retry the previous undo")
+	assert.Equal(t, 0, session.currentRound)
+	assert.Equal(t, stackLen-1, len(session.undoStack))
+}
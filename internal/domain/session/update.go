@@ -75,7 +75,8 @@ func (s *Session) NextRound(filter *shared.ImageFilters, filteredImages []*image
 		filteredImages[0], filteredImages[1] = filteredImages[1], filteredImages[0]
 	}
 
-	s.undoStack = append(s.undoStack, func() {
+	s.undoStack = append(s.undoStack, func() error {
+		curQueue, curFilter, curRound, curIdx := s.queue, s.filter, s.currentRound, s.currentIdx
 		s.queue = prevQueue
 		s.filter = prevFilter
 		s.currentRound = prevRound
@@ -86,12 +87,19 @@ func (s *Session) NextRound(filter *shared.ImageFilters, filteredImages []*image
 		if s.currentIdx >= len(s.queue) && len(s.undoStack) > 0 {
 			nextFunc := s.undoStack[len(s.undoStack)-1]
 			s.undoStack = s.undoStack[:len(s.undoStack)-1]
-			nextFunc()
+			if err := nextFunc(); err != nil {
+				// 上一步撤销失败时放回撤销栈并保持在新一轮，以便重试
+				s.undoStack = append(s.undoStack, nextFunc)
+				s.queue, s.filter, s.currentRound, s.currentIdx = curQueue, curFilter, curRound, curIdx
+				return err
+			}
 		}
+		return nil
 	})
 
-	// 开启新一轮
+	// 开启新一轮，新的扫描结果已经反映了删除和移动
 	s.currentRound++
+	clear(s.removed)
 	if filter != nil {
 		s.filter = filter
 	}
@@ -100,7 +108,7 @@ func (s *Session) NextRound(filter *shared.ImageFilters, filteredImages []*image
 	newQueue := make([]int, len(filteredImages))
 	for i, img := range filteredImages {
 		if idx, ok := s.indexByID[img.ID()]; ok {
-			s.images[idx] = img // 更新引用
+			s.refreshImage(idx, img) // 更新引用
 			newQueue[i] = idx
 		} else {
 			// 新增
@@ -134,6 +142,10 @@ func (s *Service) Update(ctx context.Context, id scalar.ID, options ...UpdateOpt
 	for _, opt := range options {
 		opt(opts)
 	}
+	// 新一轮需要完整的图片列表
+	if opts.filter != nil && sess.Loading() {
+		return ErrSessionLoading
+	}
 
 	if opts.targetKeep != nil {
 		if err := sess.UpdateTargetKeep(*opts.targetKeep); err != nil {
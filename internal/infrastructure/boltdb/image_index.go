@@ -0,0 +1,306 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	domainimage "main/internal/domain/image"
+	"main/internal/domain/metadata"
+	"main/internal/shared"
+
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// imagesBucket 保存图片记录，记录格式变化时更换名称，旧数据在打开时删除
+var imagesBucket = []byte("images.v1")
+
+// flushDelay 是写入延迟，期间的修改合并到同一个事务，避免扫描时每个文件都同步写入磁盘
+const flushDelay = time.Second
+
+// ImageIndex 使用 bbolt 持久化保存图片元数据，重启后扫描仍然只需要处理变化的文件
+//
+// 键为图片的绝对路径，值为 JSON 编码的记录
+type ImageIndex struct {
+	db     *bolt.DB
+	logger *zap.Logger
+
+	mu sync.Mutex
+	// pending 是尚未写入的修改，值为 nil 表示删除
+	pending map[string]*domainimage.IndexEntry
+	timer   *time.Timer
+}
+
+// OpenImageIndex 打开或创建索引文件
+//
+// 索引文件被其他实例占用时等待 timeout 后返回错误
+func OpenImageIndex(path string, timeout time.Duration, logger *zap.Logger) (*ImageIndex, error) {
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: timeout})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		// 删除旧格式的数据
+		var stale [][]byte
+		err := tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			if string(name) != string(imagesBucket) {
+				stale = append(stale, name)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, name := range stale {
+			if err := tx.DeleteBucket(name); err != nil {
+				return err
+			}
+		}
+		_, err = tx.CreateBucketIfNotExists(imagesBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &ImageIndex{
+		db:      db,
+		logger:  logger,
+		pending: make(map[string]*domainimage.IndexEntry),
+	}, nil
+}
+
+// Close 写入尚未保存的修改并关闭索引文件
+func (i *ImageIndex) Close() error {
+	i.mu.Lock()
+	if i.timer != nil {
+		i.timer.Stop()
+	}
+	i.mu.Unlock()
+	i.flush()
+	return i.db.Close()
+}
+
+// record 是持久化的记录格式
+type record struct {
+	Size    int64       `json:"size"`
+	ModTime time.Time   `json:"modTime"`
+	Meta    *metaRecord `json:"meta,omitempty"`
+	XMP     *xmpRecord  `json:"xmp,omitempty"`
+
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	Placeholder            string `json:"placeholder,omitempty"`
+	PlaceholderOrientation int    `json:"placeholderOrientation,omitempty"`
+}
+
+type metaRecord struct {
+	Width       int           `json:"width"`
+	Height      int           `json:"height"`
+	Orientation int           `json:"orientation,omitempty"`
+	Duration    time.Duration `json:"duration,omitempty"`
+}
+
+type xmpRecord struct {
+	Rating      int                     `json:"rating"`
+	Action      string                  `json:"action,omitempty"`
+	Timestamp   time.Time               `json:"timestamp"`
+	Note        *string                 `json:"note,omitempty"`
+	Orientation *int                    `json:"orientation,omitempty"`
+	SessionID   string                  `json:"sessionId,omitempty"`
+	History     []metadata.HistoryEvent `json:"history,omitempty"`
+	Revision    string                  `json:"revision"`
+}
+
+func (i *ImageIndex) Get(path string) *domainimage.IndexEntry {
+	i.mu.Lock()
+	entry, ok := i.pending[path]
+	i.mu.Unlock()
+	if ok {
+		return entry
+	}
+
+	var data []byte
+	err := i.db.View(func(tx *bolt.Tx) error {
+		// 数据只在事务内有效，需要复制
+		data = append(data, tx.Bucket(imagesBucket).Get([]byte(path))...)
+		return nil
+	})
+	if err != nil {
+		i.logger.Warn("failed to read image index", zap.String("path", path), zap.Error(err))
+		return nil
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	var r record
+	if err := json.Unmarshal(data, &r); err != nil {
+		i.logger.Warn("invalid image index record", zap.String("path", path), zap.Error(err))
+		return nil
+	}
+	return r.toEntry()
+}
+
+func (i *ImageIndex) Put(path string, entry *domainimage.IndexEntry) {
+	i.schedule(path, entry)
+}
+
+func (i *ImageIndex) Delete(path string) {
+	i.schedule(path, nil)
+}
+
+func (i *ImageIndex) schedule(path string, entry *domainimage.IndexEntry) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.pending[path] = entry
+	if i.timer == nil {
+		i.timer = time.AfterFunc(flushDelay, i.flush)
+	}
+}
+
+// flush 在同一个事务中写入所有尚未保存的修改
+func (i *ImageIndex) flush() {
+	i.mu.Lock()
+	pending := i.pending
+	i.pending = make(map[string]*domainimage.IndexEntry)
+	i.timer = nil
+	i.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	err := i.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(imagesBucket)
+		for path, entry := range pending {
+			if entry == nil {
+				if err := bucket.Delete([]byte(path)); err != nil {
+					return err
+				}
+				continue
+			}
+			data, err := json.Marshal(newRecord(entry))
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(path), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		i.logger.Warn("failed to write image index", zap.Int("count", len(pending)), zap.Error(err))
+	}
+}
+
+// Prune 删除已不存在的文件的记录，用于清理服务停止期间删除的文件，返回删除的记录数量
+func (i *ImageIndex) Prune(ctx context.Context) (int, error) {
+	var missing [][]byte
+	err := i.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(imagesBucket).ForEach(func(k, _ []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if _, err := os.Stat(string(k)); errors.Is(err, os.ErrNotExist) {
+				missing = append(missing, append([]byte(nil), k...))
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+	err = i.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(imagesBucket)
+		for _, k := range missing {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(missing), nil
+}
+
+func newRecord(entry *domainimage.IndexEntry) *record {
+	r := &record{
+		Size:                   entry.Size,
+		ModTime:                entry.ModTime,
+		Fingerprint:            entry.Fingerprint,
+		Placeholder:            entry.Placeholder,
+		PlaceholderOrientation: entry.PlaceholderOrientation,
+	}
+	if m := entry.Meta; m != nil {
+		r.Meta = &metaRecord{
+			Width:       m.Width,
+			Height:      m.Height,
+			Orientation: m.Orientation,
+			Duration:    m.Duration,
+		}
+	}
+	if d := entry.XMPData; d != nil {
+		r.XMP = &xmpRecord{
+			Rating:    d.Rating(),
+			Action:    d.Action(),
+			Timestamp: d.Timestamp(),
+			SessionID: d.SessionID(),
+			History:   d.History(),
+			Revision:  d.Revision(),
+		}
+		if d.HasNote() {
+			note := d.Note()
+			r.XMP.Note = &note
+		}
+		if d.HasOrientation() {
+			orientation := d.Orientation()
+			r.XMP.Orientation = &orientation
+		}
+	}
+	return r
+}
+
+func (r *record) toEntry() *domainimage.IndexEntry {
+	entry := &domainimage.IndexEntry{
+		Size:                   r.Size,
+		ModTime:                r.ModTime,
+		Fingerprint:            r.Fingerprint,
+		Placeholder:            r.Placeholder,
+		PlaceholderOrientation: r.PlaceholderOrientation,
+	}
+	if m := r.Meta; m != nil {
+		entry.Meta = &shared.ImageMeta{
+			Width:       m.Width,
+			Height:      m.Height,
+			Orientation: m.Orientation,
+			Duration:    m.Duration,
+		}
+	}
+	if x := r.XMP; x != nil {
+		options := []metadata.XMPDataOption{
+			metadata.WithSessionID(x.SessionID),
+			metadata.WithHistory(x.History),
+			metadata.WithRevision(x.Revision),
+		}
+		if x.Note != nil {
+			options = append(options, metadata.WithNote(*x.Note))
+		}
+		if x.Orientation != nil {
+			options = append(options, metadata.WithOrientation(*x.Orientation))
+		}
+		entry.XMPData = metadata.NewXMPData(x.Rating, x.Action, x.Timestamp, options...)
+	}
+	return entry
+}
+
+var _ domainimage.Index = (*ImageIndex)(nil)
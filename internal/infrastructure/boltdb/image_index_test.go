@@ -0,0 +1,92 @@
+package boltdb
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	domainimage "main/internal/domain/image"
+	"main/internal/domain/metadata"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func openTestIndex(t *testing.T, path string) *ImageIndex {
+	index, err := OpenImageIndex(path, time.Second, zap.NewNop())
+	require.NoError(t, err)
+	return index
+}
+
+func TestImageIndex_Persist(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "index.db")
+	index := openTestIndex(t, dbPath)
+
+	rating := 4
+	modTime := time.Date(2024, 5, 1, 12, 0, 0, 123456789, time.UTC)
+	entry := &domainimage.IndexEntry{
+		Size:    1024,
+		ModTime: modTime,
+		Meta:    &shared.ImageMeta{Width: 300, Height: 200, Orientation: 6},
+		XMPData: metadata.NewXMPData(4, "KEEP", modTime,
+			metadata.WithNote(""),
+			metadata.WithOrientation(3),
+			metadata.WithRevision("abc"),
+			metadata.WithHistory([]metadata.HistoryEvent{{Action: "KEEP", Rating: &rating, Timestamp: modTime}}),
+		),
+		Placeholder:            "hash",
+		PlaceholderOrientation: 3,
+	}
+	index.Put("/a.jpg", entry)
+	index.Put("/b.jpg", &domainimage.IndexEntry{Size: 1, ModTime: modTime})
+	index.Delete("/b.jpg")
+
+	// 写入前从待写入的修改中读取
+	assert.Same(t, entry, index.Get("/a.jpg"))
+	assert.Nil(t, index.Get("/b.jpg"))
+	require.NoError(t, index.Close())
+
+	index = openTestIndex(t, dbPath)
+	defer index.Close()
+	got := index.Get("/a.jpg")
+	require.NotNil(t, got)
+	assert.Equal(t, int64(1024), got.Size)
+	assert.True(t, got.ModTime.Equal(modTime))
+	assert.Equal(t, entry.Meta, got.Meta)
+	assert.Equal(t, "hash", got.Placeholder)
+	assert.Equal(t, 3, got.PlaceholderOrientation)
+	assert.Equal(t, 4, got.XMPData.Rating())
+	assert.Equal(t, "KEEP", got.XMPData.Action())
+	assert.True(t, got.XMPData.HasNote())
+	assert.Equal(t, 3, got.XMPData.Orientation())
+	assert.True(t, got.XMPData.HasOrientation())
+	assert.Equal(t, "abc", got.XMPData.Revision())
+	require.Len(t, got.XMPData.History(), 1)
+	assert.Equal(t, 4, *got.XMPData.History()[0].Rating)
+	assert.Nil(t, index.Get("/b.jpg"))
+}
+
+func TestImageIndex_Prune(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "a.jpg")
+	require.NoError(t, os.WriteFile(existing, []byte("a"), 0644))
+	removed := filepath.Join(dir, "b.jpg")
+
+	dbPath := filepath.Join(t.TempDir(), "index.db")
+	index := openTestIndex(t, dbPath)
+	index.Put(existing, &domainimage.IndexEntry{Size: 1})
+	index.Put(removed, &domainimage.IndexEntry{Size: 1})
+	require.NoError(t, index.Close())
+
+	index = openTestIndex(t, dbPath)
+	defer index.Close()
+	count, err := index.Prune(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.NotNil(t, index.Get(existing))
+	assert.Nil(t, index.Get(removed))
+}
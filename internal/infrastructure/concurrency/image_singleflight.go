@@ -3,40 +3,155 @@ package concurrency
 import (
 	"context"
 	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
 
 	appimage "main/internal/application/image"
 	"main/internal/shared"
-
-	"golang.org/x/sync/singleflight"
 )
 
+// SingleFlightImageProcessor 合并相同参数的并发请求
+//
+// 所有调用方都取消后才取消共享的处理，处理完成的结果由下层写入缓存，之后的请求可以直接使用
 type SingleFlightImageProcessor struct {
-	next  appimage.Processor
-	group singleflight.Group
+	next appimage.Processor
+
+	mu      sync.Mutex
+	flights map[string]*imageFlight
+}
+
+type imageFlight struct {
+	done   chan struct{}
+	result string
+	err    error
+
+	// refs 为仍在等待的调用方数量，callers 为各优先级的调用方数量，由 SingleFlightImageProcessor.mu 保护
+	refs    int
+	callers map[int]int
+	cancel  context.CancelFunc
+
+	// priority 为仍在等待的调用方中最高的优先级
+	//
+	// 预取的图片在排队期间变为当前图片时，已在排队的请求需要随之提升优先级；
+	// 当前图片的请求离开后恢复为剩余调用方的优先级
+	priority atomic.Int64
+}
+
+// add 加入调用方，调用时需持有 SingleFlightImageProcessor.mu
+func (f *imageFlight) add(priority int) {
+	f.refs++
+	f.callers[priority]++
+	f.updatePriority()
+}
+
+// remove 移除调用方，调用时需持有 SingleFlightImageProcessor.mu
+func (f *imageFlight) remove(priority int) {
+	f.refs--
+	if f.callers[priority]--; f.callers[priority] <= 0 {
+		delete(f.callers, priority)
+	}
+	f.updatePriority()
+}
+
+func (f *imageFlight) updatePriority() {
+	if len(f.callers) == 0 {
+		return
+	}
+	highest := math.MaxInt
+	for priority := range f.callers {
+		highest = min(highest, priority)
+	}
+	f.priority.Store(int64(highest))
+}
+
+func (f *imageFlight) getPriority() int {
+	return int(f.priority.Load())
 }
 
 func NewSingleFlightImageProcessor(next appimage.Processor) *SingleFlightImageProcessor {
 	return &SingleFlightImageProcessor{
-		next: next,
+		next:    next,
+		flights: make(map[string]*imageFlight),
 	}
 }
 
-func (p *SingleFlightImageProcessor) Process(ctx context.Context, srcPath string, width, quality int) (string, error) {
+func (p *SingleFlightImageProcessor) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
 	// Generate a key for request coalescing.
 	// Note: This key depends only on input parameters.
 	// If the underlying file changes, concurrent requests might receive the result of the first one.
 	// This is an acceptable trade-off for cache stampede protection.
-	key := fmt.Sprintf("%s|%d|%d", srcPath, width, quality)
+	key := fmt.Sprintf("%s|%d|%d|%s", srcPath, width, quality, format)
+	if orientation := appimage.OrientationFromContext(ctx); orientation != 0 {
+		// 指定的方向影响输出
+		key += fmt.Sprintf("|%d", orientation)
+	}
 
-	result, err, _ := p.group.Do(key, func() (interface{}, error) {
-		return p.next.Process(context.Background(), srcPath, width, quality)
+	priority := appimage.PriorityFromContext(ctx)
+	f := p.join(ctx, key, priority, func(ctx context.Context) (string, error) {
+		return p.next.Process(ctx, srcPath, width, quality, format)
 	})
 
-	if err != nil {
-		return "", err
+	select {
+	case <-f.done:
+		return f.result, f.err
+	case <-ctx.Done():
+		p.leave(key, f, priority)
+		return "", ctx.Err()
+	}
+}
+
+// join 加入正在进行的处理，没有时启动新的处理
+func (p *SingleFlightImageProcessor) join(ctx context.Context, key string, priority int, fn func(ctx context.Context) (string, error)) *imageFlight {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if f, ok := p.flights[key]; ok {
+		f.add(priority)
+		return f
+	}
+
+	f := &imageFlight{
+		done:    make(chan struct{}),
+		callers: make(map[int]int),
 	}
+	f.add(priority)
+	// 保留 context 值，取消由引用计数决定
+	flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	flightCtx = appimage.ContextWithDynamicPriority(flightCtx, f.getPriority)
+	f.cancel = cancel
+	p.flights[key] = f
 
-	return result.(string), nil
+	go func() {
+		defer cancel()
+		result, err := fn(flightCtx)
+
+		p.mu.Lock()
+		if p.flights[key] == f {
+			delete(p.flights, key)
+		}
+		p.mu.Unlock()
+
+		f.result, f.err = result, err
+		close(f.done)
+	}()
+	return f
+}
+
+// leave 调用方取消等待，最后一个调用方离开时取消处理
+func (p *SingleFlightImageProcessor) leave(key string, f *imageFlight, priority int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f.remove(priority)
+	if f.refs > 0 {
+		return
+	}
+	f.cancel()
+	// 之后的请求需要重新开始，不能加入已取消的处理
+	if p.flights[key] == f {
+		delete(p.flights, key)
+	}
 }
 
 func (p *SingleFlightImageProcessor) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
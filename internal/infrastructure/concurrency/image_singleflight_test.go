@@ -0,0 +1,147 @@
+package concurrency
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appimage "main/internal/application/image"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingProcessor 阻塞到 release 关闭或 context 取消
+type blockingProcessor struct {
+	calls    atomic.Int32
+	canceled atomic.Int32
+	started  chan struct{}
+	release  chan struct{}
+}
+
+func newBlockingProcessor() *blockingProcessor {
+	return &blockingProcessor{
+		started: make(chan struct{}, 10),
+		release: make(chan struct{}),
+	}
+}
+
+func (m *blockingProcessor) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
+	m.calls.Add(1)
+	m.started <- struct{}{}
+	select {
+	case <-m.release:
+		return "result", nil
+	case <-ctx.Done():
+		m.canceled.Add(1)
+		return "", ctx.Err()
+	}
+}
+
+func (m *blockingProcessor) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
+	return nil, nil
+}
+
+func TestSingleFlightImageProcessor_Coalesce(t *testing.T) {
+	next := newBlockingProcessor()
+	p := NewSingleFlightImageProcessor(next)
+
+	results := make(chan string, 2)
+	for range 2 {
+		go func() {
+			result, _ := p.Process(context.Background(), "a.jpg", 100, 0, shared.ImageFormatJPEG)
+			results <- result
+		}()
+	}
+	<-next.started
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.flights["a.jpg|100|0|JPEG"] != nil && p.flights["a.jpg|100|0|JPEG"].refs == 2
+	}, time.Second, time.Millisecond)
+
+	close(next.release)
+	assert.Equal(t, "result", <-results)
+	assert.Equal(t, "result", <-results)
+	assert.Equal(t, int32(1), next.calls.Load())
+}
+
+func TestSingleFlightImageProcessor_CancelWhenAllCallersGone(t *testing.T) {
+	next := newBlockingProcessor()
+	p := NewSingleFlightImageProcessor(next)
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	go func() {
+		_, err := p.Process(ctx1, "a.jpg", 100, 0, shared.ImageFormatJPEG)
+		errs <- err
+	}()
+	<-next.started
+	go func() {
+		_, err := p.Process(ctx2, "a.jpg", 100, 0, shared.ImageFormatJPEG)
+		errs <- err
+	}()
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.flights["a.jpg|100|0|JPEG"].refs == 2
+	}, time.Second, time.Millisecond)
+
+	cancel1()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(0), next.canceled.Load(), "Shared work should continue while another caller is waiting")
+
+	cancel2()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+	assert.Eventually(t, func() bool {
+		return next.canceled.Load() == 1
+	}, time.Second, time.Millisecond, "Shared work should be canceled when all callers are gone")
+
+	// 之后的请求重新开始处理
+	close(next.release)
+	result, err := p.Process(context.Background(), "a.jpg", 100, 0, shared.ImageFormatJPEG)
+	require.NoError(t, err)
+	assert.Equal(t, "result", result)
+	assert.Equal(t, int32(2), next.calls.Load())
+}
+
+func TestSingleFlightImageProcessor_Priority(t *testing.T) {
+	next := newBlockingProcessor()
+	p := NewSingleFlightImageProcessor(next)
+	const key = "a.jpg|100|0|JPEG"
+	flightPriority := func() int {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.flights[key].getPriority()
+	}
+
+	errs := make(chan error, 2)
+	go func() {
+		_, err := p.Process(appimage.ContextWithPriority(context.Background(), appimage.PriorityBackground), "a.jpg", 100, 0, shared.ImageFormatJPEG)
+		errs <- err
+	}()
+	<-next.started
+	assert.Equal(t, appimage.PriorityBackground, flightPriority())
+
+	// 变为当前图片时提升优先级
+	ctx, cancel := context.WithCancel(appimage.ContextWithPriority(context.Background(), appimage.PriorityCurrent))
+	go func() {
+		_, err := p.Process(ctx, "a.jpg", 100, 0, shared.ImageFormatJPEG)
+		errs <- err
+	}()
+	require.Eventually(t, func() bool {
+		return flightPriority() == appimage.PriorityCurrent
+	}, time.Second, time.Millisecond)
+
+	// 当前图片的请求离开后恢复为剩余调用方的优先级
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+	assert.Equal(t, appimage.PriorityBackground, flightPriority())
+
+	close(next.release)
+	assert.NoError(t, <-errs)
+}
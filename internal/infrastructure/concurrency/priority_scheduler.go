@@ -0,0 +1,123 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	appimage "main/internal/application/image"
+)
+
+// DefaultPriorityAging 是默认的优先级提升间隔，后台预热最多等待约 10 秒就会优先于新的当前图片
+const DefaultPriorityAging = 100 * time.Millisecond
+
+// PriorityScheduler 限制并发数量，按优先级分配空闲名额
+//
+// 优先级从 context 读取（appimage.PriorityFromContext），数值越小越优先。
+// 排队时间每达到 aging 就提升一级，避免低优先级请求被持续的高优先级请求饿死。
+type PriorityScheduler struct {
+	mu      sync.Mutex
+	size    int64
+	active  int64
+	aging   time.Duration
+	waiters []*priorityWaiter
+	seq     uint64
+}
+
+type priorityWaiter struct {
+	ctx        context.Context
+	enqueuedAt time.Time
+	seq        uint64
+	ready      chan struct{}
+}
+
+func NewPriorityScheduler(size int64, aging time.Duration) *PriorityScheduler {
+	if size <= 0 {
+		size = 1
+	}
+	return &PriorityScheduler{
+		size:  size,
+		aging: aging,
+	}
+}
+
+// Acquire 获取一个名额，成功后必须调用 Release
+func (s *PriorityScheduler) Acquire(ctx context.Context) error {
+	s.mu.Lock()
+	if s.active < s.size && len(s.waiters) == 0 {
+		s.active++
+		s.mu.Unlock()
+		return nil
+	}
+	s.seq++
+	w := &priorityWaiter{
+		ctx:        ctx,
+		enqueuedAt: time.Now(),
+		seq:        s.seq,
+		ready:      make(chan struct{}),
+	}
+	s.waiters = append(s.waiters, w)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		select {
+		case <-w.ready:
+			// 取消的同时已分配到名额，交给下一个等待者
+			s.active--
+			s.dispatch()
+		default:
+			s.remove(w)
+		}
+		return ctx.Err()
+	}
+}
+
+// Release 归还名额
+func (s *PriorityScheduler) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.active--
+	s.dispatch()
+}
+
+// dispatch 将空闲名额分配给有效优先级最高的等待者，需要持有锁
+func (s *PriorityScheduler) dispatch() {
+	now := time.Now()
+	for s.active < s.size && len(s.waiters) > 0 {
+		best := 0
+		bestPriority := s.effectivePriority(s.waiters[0], now)
+		for i, w := range s.waiters[1:] {
+			p := s.effectivePriority(w, now)
+			// 优先级相同时先到先得
+			if p < bestPriority || (p == bestPriority && w.seq < s.waiters[best].seq) {
+				best, bestPriority = i+1, p
+			}
+		}
+		w := s.waiters[best]
+		s.waiters = append(s.waiters[:best], s.waiters[best+1:]...)
+		s.active++
+		close(w.ready)
+	}
+}
+
+func (s *PriorityScheduler) effectivePriority(w *priorityWaiter, now time.Time) int {
+	p := appimage.PriorityFromContext(w.ctx)
+	if s.aging > 0 {
+		p -= int(now.Sub(w.enqueuedAt) / s.aging)
+	}
+	return p
+}
+
+func (s *PriorityScheduler) remove(w *priorityWaiter) {
+	for i, v := range s.waiters {
+		if v == w {
+			s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appimage "main/internal/application/image"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitQueued 等待指定数量的请求进入队列
+func waitQueued(t *testing.T, s *PriorityScheduler, n int) {
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.waiters) == n
+	}, time.Second, time.Millisecond)
+}
+
+func TestPriorityScheduler_Order(t *testing.T) {
+	s := NewPriorityScheduler(1, time.Hour)
+	ctx := context.Background()
+	require.NoError(t, s.Acquire(ctx))
+
+	order := make(chan int, 3)
+	for i, priority := range []int{appimage.PriorityBackground, 2, appimage.PriorityCurrent} {
+		go func() {
+			if err := s.Acquire(appimage.ContextWithPriority(ctx, priority)); err != nil {
+				return
+			}
+			order <- priority
+			s.Release()
+		}()
+		waitQueued(t, s, i+1)
+	}
+
+	s.Release()
+	assert.Equal(t, appimage.PriorityCurrent, <-order)
+	assert.Equal(t, 2, <-order)
+	assert.Equal(t, appimage.PriorityBackground, <-order)
+}
+
+func TestPriorityScheduler_Aging(t *testing.T) {
+	s := NewPriorityScheduler(1, time.Millisecond)
+	ctx := context.Background()
+	require.NoError(t, s.Acquire(ctx))
+
+	order := make(chan int, 2)
+	acquire := func(priority int) {
+		if err := s.Acquire(appimage.ContextWithPriority(ctx, priority)); err != nil {
+			return
+		}
+		order <- priority
+		s.Release()
+	}
+
+	go acquire(10)
+	waitQueued(t, s, 1)
+	time.Sleep(50 * time.Millisecond)
+	go acquire(appimage.PriorityCurrent)
+	waitQueued(t, s, 2)
+
+	s.Release()
+	assert.Equal(t, 10, <-order, "Long waiting request should not starve")
+	assert.Equal(t, appimage.PriorityCurrent, <-order)
+}
+
+func TestPriorityScheduler_DynamicPriority(t *testing.T) {
+	s := NewPriorityScheduler(1, time.Hour)
+	ctx := context.Background()
+	require.NoError(t, s.Acquire(ctx))
+
+	order := make(chan string, 2)
+	boosted := appimage.PriorityBackground
+	go func() {
+		if s.Acquire(appimage.ContextWithPriority(ctx, 1)) == nil {
+			order <- "next"
+			s.Release()
+		}
+	}()
+	waitQueued(t, s, 1)
+	go func() {
+		getPriority := func() int { return boosted }
+		if s.Acquire(appimage.ContextWithDynamicPriority(ctx, getPriority)) == nil {
+			order <- "boosted"
+			s.Release()
+		}
+	}()
+	waitQueued(t, s, 2)
+
+	s.mu.Lock()
+	boosted = appimage.PriorityCurrent
+	s.mu.Unlock()
+
+	s.Release()
+	assert.Equal(t, "boosted", <-order)
+	assert.Equal(t, "next", <-order)
+}
+
+func TestPriorityScheduler_Cancel(t *testing.T) {
+	s := NewPriorityScheduler(1, time.Hour)
+	require.NoError(t, s.Acquire(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	go func() {
+		errCh <- s.Acquire(ctx)
+	}()
+	waitQueued(t, s, 1)
+	cancel()
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+	waitQueued(t, s, 0)
+
+	s.Release()
+	require.NoError(t, s.Acquire(context.Background()), "Canceled waiter should not hold a slot")
+	s.Release()
+}
@@ -0,0 +1,157 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	appimage "main/internal/application/image"
+	"main/internal/infrastructure/concurrency"
+	"main/internal/shared"
+)
+
+// Processor 调用 ffprobe 读取视频信息，调用 ffmpeg 提取封面
+//
+// 命令可以配置为兼容的其他程序（例如测试用的桩）
+type Processor struct {
+	ffprobe   string
+	ffmpeg    string
+	cache     appimage.Cache
+	keyer     appimage.CacheKeyer
+	scheduler *concurrency.PriorityScheduler
+}
+
+func NewProcessor(ffprobe, ffmpeg string, cache appimage.Cache, keyer appimage.CacheKeyer, limit int64) *Processor {
+	if limit <= 0 {
+		limit = 4
+	}
+	return &Processor{
+		ffprobe: ffprobe,
+		ffmpeg:  ffmpeg,
+		cache:   cache,
+		keyer:   keyer,
+		// 解码视频占用较多资源，空闲名额优先分配给正在查看的视频
+		scheduler: concurrency.NewPriorityScheduler(limit, concurrency.DefaultPriorityAging),
+	}
+}
+
+// probeOutput 是 `ffprobe -of json` 的输出
+type probeOutput struct {
+	Streams []struct {
+		Width        int               `json:"width"`
+		Height       int               `json:"height"`
+		Tags         map[string]string `json:"tags"`
+		SideDataList []struct {
+			Rotation float64 `json:"rotation"`
+		} `json:"side_data_list"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+func (p *Processor) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
+	if err := p.scheduler.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.scheduler.Release()
+
+	cmd := exec.CommandContext(ctx, p.ffprobe,
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=width,height:stream_tags=rotate:stream_side_data=rotation:format=duration",
+		"-of", "json",
+		srcPath,
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("ffprobe error: %w: stderr: %q", err, stderr.String())
+	}
+	return parseProbeOutput(output)
+}
+
+func parseProbeOutput(output []byte) (*shared.ImageMeta, error) {
+	var probe probeOutput
+	if err := json.Unmarshal(output, &probe); err != nil {
+		return nil, fmt.Errorf("failed to parse ffprobe output: %w", err)
+	}
+	if len(probe.Streams) == 0 {
+		return nil, fmt.Errorf("no video stream found")
+	}
+
+	stream := probe.Streams[0]
+	meta := &shared.ImageMeta{
+		Width:  stream.Width,
+		Height: stream.Height,
+	}
+
+	// 手机拍摄的视频通过旋转元数据表示方向，ffmpeg 提取封面时会自动旋转
+	rotation, _ := strconv.ParseFloat(stream.Tags["rotate"], 64)
+	for _, sideData := range stream.SideDataList {
+		if sideData.Rotation != 0 {
+			rotation = sideData.Rotation
+		}
+	}
+	if int(math.Abs(rotation))%180 == 90 {
+		meta.Width, meta.Height = meta.Height, meta.Width
+	}
+
+	if seconds, err := strconv.ParseFloat(probe.Format.Duration, 64); err == nil {
+		meta.Duration = time.Duration(seconds * float64(time.Second))
+	}
+	return meta, nil
+}
+
+func (p *Processor) Poster(ctx context.Context, srcPath string) (string, error) {
+	cacheKey, err := p.keyer.Key(srcPath, "ffmpeg|poster")
+	if err != nil {
+		return "", err
+	}
+	cacheKey += shared.ImageFormatJPEG.Meta().Extension
+
+	if path, ok := p.cache.Lookup(cacheKey); ok {
+		return path, nil
+	}
+
+	if err := p.scheduler.Acquire(ctx); err != nil {
+		return "", err
+	}
+	defer p.scheduler.Release()
+
+	return p.cache.Save(cacheKey, func(f *os.File) error {
+		args := []string{
+			"-v", "error",
+			"-i", srcPath,
+			"-frames:v", "1",
+			"-f", "image2",
+			"-c:v", "mjpeg",
+			"-q:v", "2",
+			"pipe:1",
+		}
+		cmd := exec.CommandContext(ctx, p.ffmpeg, args...)
+		cmd.Stdout = f
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+
+		if err := cmd.Run(); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("ffmpeg error: %w, args: %v: stderr: %q", err, args, stderr.String())
+		}
+		return nil
+	})
+}
+
+var _ appimage.VideoProcessor = (*Processor)(nil)
@@ -0,0 +1,119 @@
+package ffmpeg
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"main/internal/infrastructure/localfs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCache struct {
+	dir string
+}
+
+func (m *mockCache) GetPath(key string) string {
+	return filepath.Join(m.dir, filepath.FromSlash(key))
+}
+
+func (m *mockCache) Lookup(keys ...string) (string, bool) {
+	for _, key := range keys {
+		if _, err := os.Stat(m.GetPath(key)); err == nil {
+			return m.GetPath(key), true
+		}
+	}
+	return "", false
+}
+
+func (m *mockCache) Save(key string, write func(file *os.File) error) (string, error) {
+	p := m.GetPath(key)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return "", err
+	}
+	f, err := os.Create(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return p, write(f)
+}
+
+// writeStub 写入替代 ffprobe/ffmpeg 的脚本
+func writeStub(t *testing.T, name, script string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script stubs are not supported on windows")
+	}
+	p := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(p, []byte("#!/bin/sh\n"+script), 0755))
+	return p
+}
+
+func TestParseProbeOutput(t *testing.T) {
+	meta, err := parseProbeOutput([]byte(`{
+		"streams": [{"width": 1920, "height": 1080}],
+		"format": {"duration": "4.500000"}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1920, meta.Width)
+	assert.Equal(t, 1080, meta.Height)
+	assert.Equal(t, 4500*time.Millisecond, meta.Duration)
+
+	meta, err = parseProbeOutput([]byte(`{
+		"streams": [{"width": 1920, "height": 1080, "side_data_list": [{"rotation": -90}]}],
+		"format": {}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1080, meta.Width, "Rotated video should report display size")
+	assert.Equal(t, 1920, meta.Height)
+	assert.Zero(t, meta.Duration)
+
+	meta, err = parseProbeOutput([]byte(`{"streams": [{"width": 640, "height": 480, "tags": {"rotate": "270"}}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, 480, meta.Width)
+
+	_, err = parseProbeOutput([]byte(`{"streams": []}`))
+	assert.Error(t, err)
+}
+
+func TestProcessor_Meta(t *testing.T) {
+	ffprobe := writeStub(t, "ffprobe", `echo '{"streams":[{"width":64,"height":48}],"format":{"duration":"2.0"}}'`)
+	p := NewProcessor(ffprobe, "false", &mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+
+	meta, err := p.Meta(context.Background(), "a.mp4")
+	require.NoError(t, err)
+	assert.Equal(t, 64, meta.Width)
+	assert.Equal(t, 48, meta.Height)
+	assert.Equal(t, 2*time.Second, meta.Duration)
+
+	failing := writeStub(t, "ffprobe", "echo 'invalid data' >&2; exit 1")
+	p = NewProcessor(failing, "false", &mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	_, err = p.Meta(context.Background(), "a.mp4")
+	assert.ErrorContains(t, err, "invalid data")
+}
+
+func TestProcessor_Poster(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "calls")
+	ffmpeg := writeStub(t, "ffmpeg", "echo x >> '"+counter+"'; printf poster")
+	p := NewProcessor("false", ffmpeg, &mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	video := filepath.Join(t.TempDir(), "a.mp4")
+	require.NoError(t, os.WriteFile(video, []byte("video"), 0644))
+
+	out, err := p.Poster(context.Background(), video)
+	require.NoError(t, err)
+	assert.Equal(t, ".jpg", filepath.Ext(out))
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "poster", string(data))
+
+	_, err = p.Poster(context.Background(), video)
+	require.NoError(t, err)
+	calls, err := os.ReadFile(counter)
+	require.NoError(t, err)
+	assert.Equal(t, "x\n", string(calls), "Cached poster should be reused")
+}
@@ -0,0 +1,107 @@
+package icc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sync"
+)
+
+// SRGB 返回 sRGB 配置文件，用于指定外部工具的转换目标
+var SRGB = sync.OnceValue(func() []byte {
+	trc := make([]uint16, 1024)
+	for i := range trc {
+		trc[i] = uint16(math.Round(srgbToLinear(float64(i)/float64(len(trc)-1)) * 65535))
+	}
+	return encode("sRGB", srgbMatrix, trc)
+})
+
+// encode 生成 ICC v2 显示器配置文件
+func encode(description string, matrix [3][3]float64, trc []uint16) []byte {
+	type tag struct {
+		sig  string
+		data []byte
+	}
+	column := func(i int) []byte {
+		return xyzTag(matrix[0][i], matrix[1][i], matrix[2][i])
+	}
+	curv := new(bytes.Buffer)
+	curv.WriteString("curv\x00\x00\x00\x00")
+	binary.Write(curv, binary.BigEndian, uint32(len(trc)))
+	binary.Write(curv, binary.BigEndian, trc)
+
+	tags := []tag{
+		{"desc", descTag(description)},
+		{"cprt", textTag("No copyright, use freely")},
+		{"wtpt", xyzTag(0.9642, 1, 0.8249)},
+		{"rXYZ", column(0)},
+		{"gXYZ", column(1)},
+		{"bXYZ", column(2)},
+		{"rTRC", curv.Bytes()},
+	}
+
+	// 三条曲线相同，共用数据
+	const tagCount = 9
+	offset := headerSize + 4 + tagCount*12
+	table := new(bytes.Buffer)
+	body := new(bytes.Buffer)
+	binary.Write(table, binary.BigEndian, uint32(tagCount))
+	for _, t := range tags {
+		writeTagEntry(table, t.sig, offset+body.Len(), len(t.data))
+		if t.sig == "rTRC" {
+			writeTagEntry(table, "gTRC", offset+body.Len(), len(t.data))
+			writeTagEntry(table, "bTRC", offset+body.Len(), len(t.data))
+		}
+		body.Write(t.data)
+		// 标签数据按 4 字节对齐
+		for body.Len()%4 != 0 {
+			body.WriteByte(0)
+		}
+	}
+
+	size := headerSize + table.Len() + body.Len()
+	header := make([]byte, headerSize)
+	binary.BigEndian.PutUint32(header[0:], uint32(size))
+	binary.BigEndian.PutUint32(header[8:], 0x02100000)
+	copy(header[12:], "mntr")
+	copy(header[16:], "RGB ")
+	copy(header[20:], "XYZ ")
+	copy(header[36:], "acsp")
+	// PCS 光源为 D50
+	copy(header[68:], xyzTag(0.9642, 1, 0.8249)[8:])
+
+	return bytes.Join([][]byte{header, table.Bytes(), body.Bytes()}, nil)
+}
+
+func writeTagEntry(w *bytes.Buffer, sig string, offset, size int) {
+	w.WriteString(sig)
+	binary.Write(w, binary.BigEndian, uint32(offset))
+	binary.Write(w, binary.BigEndian, uint32(size))
+}
+
+func xyzTag(x, y, z float64) []byte {
+	b := []byte("XYZ \x00\x00\x00\x00")
+	for _, v := range []float64{x, y, z} {
+		b = binary.BigEndian.AppendUint32(b, uint32(int32(math.Round(v*65536))))
+	}
+	return b
+}
+
+func textTag(s string) []byte {
+	return append([]byte("text\x00\x00\x00\x00"+s), 0)
+}
+
+// descTag 生成 v2 的 textDescriptionType，只包含 ASCII 描述
+func descTag(s string) []byte {
+	b := []byte("desc\x00\x00\x00\x00")
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)+1))
+	b = append(b, s...)
+	b = append(b, 0)
+	// Unicode 语言及长度
+	b = binary.BigEndian.AppendUint32(b, 0)
+	b = binary.BigEndian.AppendUint32(b, 0)
+	// ScriptCode 代码、长度及固定 67 字节的内容
+	b = binary.BigEndian.AppendUint16(b, 0)
+	b = append(b, 0)
+	return append(b, make([]byte, 67)...)
+}
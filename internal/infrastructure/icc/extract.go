@@ -0,0 +1,112 @@
+package icc
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"sort"
+)
+
+// Extract 从 JPEG/PNG/WebP 文件内容中提取嵌入的 ICC 配置文件，没有时返回 nil
+func Extract(data []byte) []byte {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8}):
+		return extractJPEG(data)
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return extractPNG(data)
+	case len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return extractWebP(data)
+	}
+	return nil
+}
+
+// extractJPEG 拼接 APP2 段中按序号拆分的配置文件
+func extractJPEG(data []byte) []byte {
+	const marker = "ICC_PROFILE\x00"
+	type chunk struct {
+		seq  byte
+		data []byte
+	}
+	var chunks []chunk
+	i := 2
+	for i+4 <= len(data) && data[i] == 0xFF {
+		m := data[i+1]
+		if m == 0xDA || m == 0xD9 {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			break
+		}
+		segment := data[i+4 : end]
+		if m == 0xE2 && len(segment) > len(marker)+2 && string(segment[:len(marker)]) == marker {
+			chunks = append(chunks, chunk{segment[len(marker)], segment[len(marker)+2:]})
+		}
+		i = end
+	}
+	if len(chunks) == 0 {
+		return nil
+	}
+	sort.SliceStable(chunks, func(a, b int) bool { return chunks[a].seq < chunks[b].seq })
+	var profile []byte
+	for _, c := range chunks {
+		profile = append(profile, c.data...)
+	}
+	return profile
+}
+
+func extractPNG(data []byte) []byte {
+	i := 8
+	for i+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		chunkType := string(data[i+4 : i+8])
+		start := i + 8
+		end := start + length
+		if length < 0 || end+4 > len(data) {
+			break
+		}
+		switch chunkType {
+		case "iCCP":
+			// 名称、空字符、压缩方式，之后为 zlib 压缩的配置文件
+			name, compressed, ok := bytes.Cut(data[start:end], []byte{0})
+			if !ok || len(name) == 0 || len(compressed) < 1 {
+				return nil
+			}
+			r, err := zlib.NewReader(bytes.NewReader(compressed[1:]))
+			if err != nil {
+				return nil
+			}
+			defer r.Close()
+			profile, err := io.ReadAll(r)
+			if err != nil {
+				return nil
+			}
+			return profile
+		case "IDAT":
+			// iCCP 必须在 IDAT 之前
+			return nil
+		}
+		i = end + 4
+	}
+	return nil
+}
+
+func extractWebP(data []byte) []byte {
+	i := 12
+	for i+8 <= len(data) {
+		fourCC := string(data[i : i+4])
+		length := int(binary.LittleEndian.Uint32(data[i+4:]))
+		start := i + 8
+		end := start + length
+		if length < 0 || end > len(data) {
+			break
+		}
+		if fourCC == "ICCP" {
+			return data[start:end]
+		}
+		i = end + length%2
+	}
+	return nil
+}
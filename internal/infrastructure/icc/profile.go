@@ -0,0 +1,202 @@
+package icc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Profile 是矩阵/TRC 类型的 RGB ICC 配置文件
+//
+// 常见的广色域配置文件（Display P3、Adobe RGB、ProPhoto RGB）都属于这一类型，
+// 基于查找表（LUT）的配置文件不支持
+type Profile struct {
+	// matrix 将线性 RGB 转换为 PCS XYZ（D50），列分别为红、绿、蓝原色
+	matrix [3][3]float64
+	curves [3]curve
+}
+
+// curve 是色调响应曲线，将编码值转换为线性值，输入输出范围均为 [0, 1]
+type curve func(float64) float64
+
+var ErrUnsupportedProfile = errors.New("unsupported ICC profile")
+
+const headerSize = 128
+
+// Parse 解析 ICC 配置文件
+func Parse(data []byte) (*Profile, error) {
+	if len(data) < headerSize+4 || string(data[36:40]) != "acsp" {
+		return nil, errors.New("invalid ICC profile")
+	}
+	if string(data[16:20]) != "RGB " {
+		return nil, fmt.Errorf("%w: color space %q", ErrUnsupportedProfile, data[16:20])
+	}
+
+	tags := make(map[string][]byte)
+	count := int(binary.BigEndian.Uint32(data[headerSize:]))
+	for i := range count {
+		entry := headerSize + 4 + i*12
+		if entry+12 > len(data) {
+			break
+		}
+		offset := int(binary.BigEndian.Uint32(data[entry+4:]))
+		size := int(binary.BigEndian.Uint32(data[entry+8:]))
+		if offset < 0 || size < 0 || offset+size > len(data) {
+			continue
+		}
+		tags[string(data[entry:entry+4])] = data[offset : offset+size]
+	}
+
+	p := new(Profile)
+	for i, sig := range []string{"rXYZ", "gXYZ", "bXYZ"} {
+		xyz, err := parseXYZ(tags[sig])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUnsupportedProfile, sig, err)
+		}
+		for row := range 3 {
+			p.matrix[row][i] = xyz[row]
+		}
+	}
+	for i, sig := range []string{"rTRC", "gTRC", "bTRC"} {
+		c, err := parseCurve(tags[sig])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUnsupportedProfile, sig, err)
+		}
+		p.curves[i] = c
+	}
+	return p, nil
+}
+
+// s15Fixed16 读取 ICC 的有符号 16.16 定点数
+func s15Fixed16(data []byte) float64 {
+	return float64(int32(binary.BigEndian.Uint32(data))) / 65536
+}
+
+func parseXYZ(data []byte) ([3]float64, error) {
+	if len(data) < 20 || string(data[0:4]) != "XYZ " {
+		return [3]float64{}, errors.New("invalid XYZ tag")
+	}
+	return [3]float64{s15Fixed16(data[8:]), s15Fixed16(data[12:]), s15Fixed16(data[16:])}, nil
+}
+
+func parseCurve(data []byte) (curve, error) {
+	if len(data) < 12 {
+		return nil, errors.New("invalid curve tag")
+	}
+	switch string(data[0:4]) {
+	case "curv":
+		n := int(binary.BigEndian.Uint32(data[8:]))
+		switch {
+		case n == 0:
+			return func(v float64) float64 { return v }, nil
+		case n == 1:
+			gamma := float64(binary.BigEndian.Uint16(data[12:])) / 256
+			return func(v float64) float64 { return math.Pow(v, gamma) }, nil
+		case len(data) < 12+n*2:
+			return nil, errors.New("truncated curve table")
+		}
+		table := make([]float64, n)
+		for i := range table {
+			table[i] = float64(binary.BigEndian.Uint16(data[12+i*2:])) / 65535
+		}
+		return func(v float64) float64 {
+			pos := v * float64(n-1)
+			i := min(int(pos), n-2)
+			return table[i] + (table[i+1]-table[i])*(pos-float64(i))
+		}, nil
+	case "para":
+		return parseParametricCurve(data)
+	}
+	return nil, fmt.Errorf("unsupported curve type %q", data[0:4])
+}
+
+// parseParametricCurve 解析参数曲线，参数个数由函数类型决定
+func parseParametricCurve(data []byte) (curve, error) {
+	paramCounts := [...]int{1, 3, 4, 5, 7}
+	funcType := int(binary.BigEndian.Uint16(data[8:]))
+	if funcType >= len(paramCounts) || len(data) < 12+paramCounts[funcType]*4 {
+		return nil, errors.New("invalid parametric curve")
+	}
+	// g, a, b, c, d, e, f
+	params := [7]float64{1, 1, 0, 0, 0, 0, 0}
+	for i := range paramCounts[funcType] {
+		params[i] = s15Fixed16(data[12+i*4:])
+	}
+	g, a, b, c, d, e, f := params[0], params[1], params[2], params[3], params[4], params[5], params[6]
+	switch funcType {
+	case 0:
+		return func(v float64) float64 { return math.Pow(v, g) }, nil
+	case 1:
+		return func(v float64) float64 {
+			if v >= -b/a {
+				return math.Pow(a*v+b, g)
+			}
+			return 0
+		}, nil
+	case 2:
+		return func(v float64) float64 {
+			if v >= -b/a {
+				return math.Pow(a*v+b, g) + c
+			}
+			return c
+		}, nil
+	case 3:
+		return func(v float64) float64 {
+			if v >= d {
+				return math.Pow(a*v+b, g)
+			}
+			return c * v
+		}, nil
+	default:
+		return func(v float64) float64 {
+			if v >= d {
+				return math.Pow(a*v+b, g) + e
+			}
+			return c*v + f
+		}, nil
+	}
+}
+
+// srgbMatrix 是适配到 D50 的 sRGB 原色，与标准 sRGB 配置文件一致
+var srgbMatrix = [3][3]float64{
+	{0.4360747, 0.3850649, 0.1430804},
+	{0.2225045, 0.7168786, 0.0606169},
+	{0.0139322, 0.0971045, 0.7141733},
+}
+
+func srgbToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// IsSRGB 判断配置文件是否与 sRGB 等效，等效时无需转换
+func (p *Profile) IsSRGB() bool {
+	// 各工具生成的 sRGB 配置文件在定点数精度上略有差异
+	const tolerance = 0.002
+	for row := range 3 {
+		for col := range 3 {
+			if math.Abs(p.matrix[row][col]-srgbMatrix[row][col]) > tolerance {
+				return false
+			}
+		}
+	}
+	for _, c := range p.curves {
+		for i := 0; i <= 16; i++ {
+			v := float64(i) / 16
+			if math.Abs(c(v)-srgbToLinear(v)) > 0.005 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package icc
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// displayP3 生成 Display P3 配置文件，使用 sRGB 的色调响应曲线
+func displayP3() []byte {
+	srgb, _ := Parse(SRGB())
+	trc := make([]uint16, 1024)
+	for i := range trc {
+		trc[i] = uint16(srgb.curves[0](float64(i)/1023)*65535 + 0.5)
+	}
+	return encode("Display P3", [3][3]float64{
+		{0.515102, 0.291965, 0.157153},
+		{0.241182, 0.692236, 0.066582},
+		{-0.001049, 0.041885, 0.784378},
+	}, trc)
+}
+
+func TestParse_SRGB(t *testing.T) {
+	p, err := Parse(SRGB())
+	require.NoError(t, err)
+	assert.True(t, p.IsSRGB())
+
+	p, err = Parse(displayP3())
+	require.NoError(t, err)
+	assert.False(t, p.IsSRGB())
+
+	_, err = Parse([]byte("not a profile"))
+	assert.Error(t, err)
+}
+
+func TestTransform_Apply(t *testing.T) {
+	p, err := Parse(displayP3())
+	require.NoError(t, err)
+	transform := NewTransform(p)
+
+	img := image.NewRGBA(image.Rect(0, 0, 3, 1))
+	img.SetRGBA(0, 0, color.RGBA{255, 0, 0, 255})
+	img.SetRGBA(1, 0, color.RGBA{128, 128, 128, 255})
+	img.SetRGBA(2, 0, color.RGBA{100, 0, 0, 128}) // 预乘透明度
+	transform.Apply(img)
+
+	red := img.RGBAAt(0, 0)
+	assert.Equal(t, uint8(255), red.R)
+	assert.Less(t, red.G, uint8(5), "P3 red is outside sRGB gamut and should be clipped")
+	assert.Less(t, red.B, uint8(5))
+
+	gray := img.RGBAAt(1, 0)
+	assert.InDelta(t, 128, int(gray.R), 1, "Neutral colors should be preserved")
+	assert.InDelta(t, 128, int(gray.G), 1)
+	assert.InDelta(t, 128, int(gray.B), 1)
+
+	translucent := img.RGBAAt(2, 0)
+	assert.Equal(t, uint8(128), translucent.A)
+	assert.LessOrEqual(t, translucent.R, uint8(128))
+}
+
+func TestExtract(t *testing.T) {
+	profile := displayP3()
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+
+	jpegBuf := new(bytes.Buffer)
+	require.NoError(t, jpeg.Encode(jpegBuf, img, nil))
+	segment := append([]byte("ICC_PROFILE\x00\x01\x01"), profile...)
+	app2 := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE2}, uint16(len(segment)+2))
+	data := append(append([]byte{0xFF, 0xD8}, append(app2, segment...)...), jpegBuf.Bytes()[2:]...)
+	assert.Equal(t, profile, Extract(data))
+
+	pngBuf := new(bytes.Buffer)
+	require.NoError(t, png.Encode(pngBuf, img))
+	compressed := new(bytes.Buffer)
+	zw := zlib.NewWriter(compressed)
+	zw.Write(profile)
+	zw.Close()
+	payload := append([]byte("P3\x00\x00"), compressed.Bytes()...)
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+	chunk = append(chunk, "iCCP"...)
+	chunk = append(chunk, payload...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+	// 插入到 IHDR 之后
+	pngData := pngBuf.Bytes()
+	data = append(append(append([]byte{}, pngData[:33]...), chunk...), pngData[33:]...)
+	assert.Equal(t, profile, Extract(data))
+
+	assert.Nil(t, Extract(jpegBuf.Bytes()))
+}
@@ -0,0 +1,103 @@
+package icc
+
+import (
+	"image"
+	"math"
+)
+
+// outputLUTSize 是线性值到 sRGB 编码值查找表的精度，暗部需要较高精度避免色阶断层
+const outputLUTSize = 4096
+
+// Transform 将配置文件描述的颜色转换为 sRGB
+type Transform struct {
+	input  [3][256]float64
+	matrix [3][3]float64
+	output [outputLUTSize + 1]uint8
+}
+
+// NewTransform 创建转换到 sRGB 的转换，使用相对色度意图，超出 sRGB 色域的颜色被截断
+func NewTransform(p *Profile) *Transform {
+	t := new(Transform)
+	for ch, c := range p.curves {
+		for i := range 256 {
+			t.input[ch][i] = c(float64(i) / 255)
+		}
+	}
+	inverse := invert(srgbMatrix)
+	for row := range 3 {
+		for col := range 3 {
+			for k := range 3 {
+				t.matrix[row][col] += inverse[row][k] * p.matrix[k][col]
+			}
+		}
+	}
+	for i := range t.output {
+		t.output[i] = uint8(math.Round(linearToSRGB(float64(i)/outputLUTSize) * 255))
+	}
+	return t
+}
+
+// Apply 原地转换图片颜色
+func (t *Transform) Apply(img *image.RGBA) {
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		row := img.Pix[img.PixOffset(b.Min.X, y):img.PixOffset(b.Max.X, y)]
+		for i := 0; i+4 <= len(row); i += 4 {
+			a := row[i+3]
+			if a == 0 {
+				continue
+			}
+			// image.RGBA 使用预乘透明度
+			var rgb [3]uint8
+			for ch := range 3 {
+				v := row[i+ch]
+				if a != 255 {
+					v = uint8(min(255, (int(v)*255+int(a)/2)/int(a)))
+				}
+				rgb[ch] = v
+			}
+			r, g, bl := t.input[0][rgb[0]], t.input[1][rgb[1]], t.input[2][rgb[2]]
+			for ch := range 3 {
+				m := t.matrix[ch]
+				v := t.output[lutIndex(m[0]*r+m[1]*g+m[2]*bl)]
+				if a != 255 {
+					v = uint8((int(v)*int(a) + 127) / 255)
+				}
+				row[i+ch] = v
+			}
+		}
+	}
+}
+
+func lutIndex(v float64) int {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 1 {
+		return outputLUTSize
+	}
+	return int(v*outputLUTSize + 0.5)
+}
+
+func invert(m [3][3]float64) [3][3]float64 {
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+	return [3][3]float64{
+		{
+			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / det,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) / det,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) / det,
+		},
+		{
+			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / det,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) / det,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) / det,
+		},
+		{
+			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / det,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) / det,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) / det,
+		},
+	}
+}
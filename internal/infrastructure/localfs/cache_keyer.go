@@ -0,0 +1,97 @@
+package localfs
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	appimage "main/internal/application/image"
+)
+
+// PathCacheKeyer 按源图片路径、修改时间和大小生成缓存键
+//
+// 重命名或移动文件后缓存失效
+type PathCacheKeyer struct{}
+
+func NewPathCacheKeyer() *PathCacheKeyer {
+	return &PathCacheKeyer{}
+}
+
+func (k *PathCacheKeyer) Key(srcPath, params string) (string, error) {
+	info, err := os.Stat(srcPath)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := k.Namespace(srcPath)
+	if err != nil {
+		return "", err
+	}
+	return cacheKey(namespace, fmt.Sprintf("%s|%d|%d|%s", srcPath, info.ModTime().Unix(), info.Size(), params)), nil
+}
+
+func (k *PathCacheKeyer) Namespace(srcPath string) (string, error) {
+	hash := sha256.Sum256([]byte(srcPath))
+	return hex.EncodeToString(hash[:16]), nil
+}
+
+// ContentCacheKeyer 按源图片内容的哈希生成缓存键
+//
+// 重命名或移动文件后缓存仍然有效，内容相同的图片共用缓存。
+// 哈希按路径、修改时间和大小记忆，文件未变化时不会重新读取
+type ContentCacheKeyer struct {
+	hashes *hashMemo
+}
+
+func NewContentCacheKeyer() *ContentCacheKeyer {
+	return &ContentCacheKeyer{
+		hashes: newHashMemo(defaultHashMemoCapacity),
+	}
+}
+
+func (k *ContentCacheKeyer) Key(srcPath, params string) (string, error) {
+	namespace, err := k.Namespace(srcPath)
+	if err != nil {
+		return "", err
+	}
+	return cacheKey(namespace, params), nil
+}
+
+// Namespace 返回内容哈希，即内容相同的图片共用命名空间
+func (k *ContentCacheKeyer) Namespace(srcPath string) (string, error) {
+	info, err := os.Stat(srcPath)
+	if err != nil {
+		return "", err
+	}
+	return k.hashes.get(srcPath, info.Size(), info.ModTime(), func() (string, error) {
+		return hashFile(srcPath)
+	})
+}
+
+func hashFile(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// cacheKey 在命名空间下使用 data 的哈希作为缓存项名称
+func cacheKey(namespace, data string) string {
+	hash := sha256.Sum256([]byte(data))
+	return path.Join(namespace, base64.URLEncoding.EncodeToString(hash[:]))
+}
+
+var (
+	_ appimage.CacheKeyer = (*PathCacheKeyer)(nil)
+	_ appimage.CacheKeyer = (*ContentCacheKeyer)(nil)
+)
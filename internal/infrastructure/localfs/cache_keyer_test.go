@@ -0,0 +1,70 @@
+package localfs
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathCacheKeyer(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.png")
+	b := filepath.Join(dir, "b.png")
+	require.NoError(t, os.WriteFile(a, []byte("same"), 0644))
+	require.NoError(t, os.WriteFile(b, []byte("same"), 0644))
+
+	keyer := NewPathCacheKeyer()
+	keyA, err := keyer.Key(a, "w=256")
+	require.NoError(t, err)
+	keyB, err := keyer.Key(b, "w=256")
+	require.NoError(t, err)
+	assert.NotEqual(t, keyA, keyB)
+
+	namespace, err := keyer.Namespace(a)
+	require.NoError(t, err)
+	assert.Equal(t, namespace, path.Dir(keyA))
+
+	_, err = keyer.Key(filepath.Join(dir, "missing.png"), "w=256")
+	assert.Error(t, err)
+}
+
+func TestContentCacheKeyer(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.png")
+	b := filepath.Join(dir, "sub", "b.png")
+	require.NoError(t, os.WriteFile(a, []byte("same"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(b), 0755))
+	require.NoError(t, os.WriteFile(b, []byte("same"), 0644))
+
+	keyer := NewContentCacheKeyer()
+	keyA, err := keyer.Key(a, "w=256")
+	require.NoError(t, err)
+	keyB, err := keyer.Key(b, "w=256")
+	require.NoError(t, err)
+	assert.Equal(t, keyA, keyB, "identical content should share the key")
+
+	other, err := keyer.Key(a, "w=512")
+	require.NoError(t, err)
+	assert.NotEqual(t, keyA, other)
+	assert.Equal(t, path.Dir(keyA), path.Dir(other))
+
+	// 重命名后缓存键不变
+	renamed := filepath.Join(dir, "renamed.png")
+	require.NoError(t, os.Rename(a, renamed))
+	keyRenamed, err := keyer.Key(renamed, "w=256")
+	require.NoError(t, err)
+	assert.Equal(t, keyA, keyRenamed)
+
+	// 内容变化后重新计算
+	require.NoError(t, os.WriteFile(renamed, []byte("changed"), 0644))
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(renamed, future, future))
+	keyChanged, err := keyer.Key(renamed, "w=256")
+	require.NoError(t, err)
+	assert.NotEqual(t, keyA, keyChanged)
+}
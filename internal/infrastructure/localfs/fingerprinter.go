@@ -0,0 +1,68 @@
+package localfs
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"time"
+
+	domainimage "main/internal/domain/image"
+)
+
+// fingerprintChunkSize 是指纹读取的文件头部和尾部的长度
+//
+// 头部通常包含 EXIF（拍摄时间、相机序列号等），尾部是图像数据的结尾，配合文件大小足以区分不同图片
+const fingerprintChunkSize = 16 << 10
+
+// PartialFingerprinter 使用文件大小和头尾部分内容的哈希作为指纹
+//
+// 只读取少量数据，扫描大目录时也很快。
+// 指纹按路径、修改时间和大小记忆，文件未变化时不会重新读取，记忆的路径数量有上限
+type PartialFingerprinter struct {
+	fingerprints *hashMemo
+}
+
+func NewPartialFingerprinter() *PartialFingerprinter {
+	return &PartialFingerprinter{
+		fingerprints: newHashMemo(defaultHashMemoCapacity),
+	}
+}
+
+func (p *PartialFingerprinter) Fingerprint(path string, size int64, modTime time.Time) (string, error) {
+	return p.fingerprints.get(path, size, modTime, func() (string, error) {
+		return partialHashFile(path, size)
+	})
+}
+
+// Forget 删除路径记忆的指纹，用于文件删除后释放内存
+func (p *PartialFingerprinter) Forget(path string) {
+	p.fingerprints.forget(path)
+}
+
+func partialHashFile(name string, size int64) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	binary.Write(hash, binary.LittleEndian, size)
+	if size <= 2*fingerprintChunkSize {
+		if _, err := io.Copy(hash, f); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+	if _, err := io.Copy(hash, io.NewSectionReader(f, 0, fingerprintChunkSize)); err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(hash, io.NewSectionReader(f, size-fingerprintChunkSize, fingerprintChunkSize)); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+var _ domainimage.Fingerprinter = (*PartialFingerprinter)(nil)
@@ -0,0 +1,74 @@
+package localfs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fingerprintOf(t *testing.T, p *PartialFingerprinter, name string) string {
+	info, err := os.Stat(name)
+	require.NoError(t, err)
+	fingerprint, err := p.Fingerprint(name, info.Size(), info.ModTime())
+	require.NoError(t, err)
+	return fingerprint
+}
+
+func TestPartialFingerprinter(t *testing.T) {
+	dir := t.TempDir()
+	large := bytes.Repeat([]byte{1}, 3*fingerprintChunkSize)
+	a := filepath.Join(dir, "a.jpg")
+	b := filepath.Join(dir, "b.jpg")
+	small := filepath.Join(dir, "small.jpg")
+	require.NoError(t, os.WriteFile(a, large, 0644))
+	require.NoError(t, os.WriteFile(b, large, 0644))
+	require.NoError(t, os.WriteFile(small, []byte("small"), 0644))
+
+	p := NewPartialFingerprinter()
+	fingerprint := fingerprintOf(t, p, a)
+	assert.Equal(t, fingerprint, fingerprintOf(t, p, b), "same content should have the same fingerprint")
+	assert.NotEqual(t, fingerprint, fingerprintOf(t, p, small))
+
+	// 头部变化
+	changed := bytes.Clone(large)
+	changed[0] = 2
+	require.NoError(t, os.WriteFile(b, changed, 0644))
+	assert.NotEqual(t, fingerprint, fingerprintOf(t, p, b))
+
+	// 尾部变化
+	changed = bytes.Clone(large)
+	changed[len(changed)-1] = 2
+	require.NoError(t, os.WriteFile(b, changed, 0644))
+	assert.NotEqual(t, fingerprint, fingerprintOf(t, p, b))
+
+	// 大小变化
+	require.NoError(t, os.WriteFile(b, append(bytes.Clone(large), 1), 0644))
+	assert.NotEqual(t, fingerprint, fingerprintOf(t, p, b))
+}
+
+func TestPartialFingerprinter_Memoized(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.jpg")
+	require.NoError(t, os.WriteFile(a, []byte("content"), 0644))
+
+	p := NewPartialFingerprinter()
+	fingerprint := fingerprintOf(t, p, a)
+
+	info, err := os.Stat(a)
+	require.NoError(t, err)
+
+	// 大小和修改时间未变化时不重新读取
+	require.NoError(t, os.Remove(a))
+	cached, err := p.Fingerprint(a, info.Size(), info.ModTime())
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, cached)
+
+	// 删除后不再记忆
+	p.Forget(a)
+	_, err = p.Fingerprint(a, info.Size(), info.ModTime())
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package localfs
+
+import (
+	"sync"
+	"time"
+
+	"main/internal/forked/container/list"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultHashMemoCapacity 是默认记忆的路径数量，足够覆盖常见的图片库
+const defaultHashMemoCapacity = 1 << 16
+
+// hashMemo 按路径、修改时间和大小记忆文件的哈希，超过容量时淘汰最久未使用的路径
+type hashMemo struct {
+	capacity int
+	group    singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]*list.Element[*hashMemoEntry]
+	lru     *list.List[*hashMemoEntry] // 最近使用的在前
+}
+
+type hashMemoEntry struct {
+	path string
+	contentHash
+}
+
+type contentHash struct {
+	modTime time.Time
+	size    int64
+	hash    string
+}
+
+func newHashMemo(capacity int) *hashMemo {
+	return &hashMemo{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element[*hashMemoEntry]),
+		lru:      list.New[*hashMemoEntry](),
+	}
+}
+
+// get 返回记忆的哈希，文件变化或未记忆时调用 compute 计算，同一路径并发请求时只计算一次
+func (m *hashMemo) get(path string, size int64, modTime time.Time, compute func() (string, error)) (string, error) {
+	m.mu.Lock()
+	if el, ok := m.entries[path]; ok && el.Value.modTime.Equal(modTime) && el.Value.size == size {
+		m.lru.MoveToFront(el)
+		m.mu.Unlock()
+		return el.Value.hash, nil
+	}
+	m.mu.Unlock()
+
+	v, err, _ := m.group.Do(path, func() (any, error) {
+		hash, err := compute()
+		if err != nil {
+			return "", err
+		}
+		m.put(path, contentHash{modTime: modTime, size: size, hash: hash})
+		return hash, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+func (m *hashMemo) put(path string, h contentHash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[path]; ok {
+		el.Value.contentHash = h
+		m.lru.MoveToFront(el)
+		return
+	}
+	m.entries[path] = m.lru.PushFront(&hashMemoEntry{path: path, contentHash: h})
+	for m.capacity > 0 && m.lru.Len() > m.capacity {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.entries, oldest.Value.path)
+	}
+}
+
+// forget 删除路径的记忆
+func (m *hashMemo) forget(path string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[path]; ok {
+		m.lru.Remove(el)
+		delete(m.entries, path)
+	}
+}
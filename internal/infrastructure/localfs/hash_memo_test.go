@@ -0,0 +1,39 @@
+package localfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashMemo(t *testing.T) {
+	memo := newHashMemo(2)
+	var calls int
+	get := func(path string, size int64) string {
+		hash, err := memo.get(path, size, time.Unix(0, 0), func() (string, error) {
+			calls++
+			return path, nil
+		})
+		require.NoError(t, err)
+		return hash
+	}
+
+	assert.Equal(t, "a", get("a", 1))
+	get("b", 1)
+	get("a", 1)
+	assert.Equal(t, 2, calls, "Unchanged file should use memoized hash")
+
+	get("a", 2)
+	assert.Equal(t, 3, calls, "Changed file should be hashed again")
+
+	// 超过容量时淘汰最久未使用的 b
+	get("c", 1)
+	get("a", 2)
+	assert.Equal(t, 4, calls)
+	get("b", 1)
+	assert.Equal(t, 5, calls)
+	assert.Equal(t, 2, memo.lru.Len())
+	assert.Len(t, memo.entries, 2)
+}
@@ -1,87 +1,287 @@
 package localfs
 
 import (
-	"context"
-	"log"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	appimage "main/internal/application/image"
+	"main/internal/forked/container/list"
+	"main/internal/shared"
+	"main/internal/util"
+
+	"go.uber.org/zap"
 )
 
+// imageCacheDir 是缓存目录下存放缓存项的子目录
+//
+// 缓存目录可能由用户指定为与其他程序共享的目录，清理时只处理该子目录
+const imageCacheDir = "images"
+
+// ImageCache 是处理后图片的磁盘缓存，总大小超过上限时按最近最少使用淘汰
+//
+// 缓存键中的第一级目录是源图片的命名空间，用于按源图片清除
 type ImageCache struct {
-	rootDir         string
-	cleanupInterval time.Duration
-	maxAge          time.Duration
+	rootDir string // 存放缓存项的目录，即缓存目录下的 imageCacheDir
+	maxSize int64
+	logger  *zap.Logger
+
+	mu      sync.Mutex
+	entries map[string]*list.Element[*cacheEntry]
+	lru     *list.List[*cacheEntry] // 最近使用的在前
+	size    int64
+	hits    int64
+	misses  int64
+}
+
+type cacheEntry struct {
+	key  string
+	size int64
 }
 
-func NewImageCache(rootDir string, cleanupInterval, maxAge time.Duration) (*ImageCache, func()) {
-	os.MkdirAll(rootDir, 0755)
+// NewImageCache 创建图片缓存并索引目录中已有的缓存文件，maxSize 不大于 0 时不限制大小
+//
+// 缓存项存放在 dir 下的独立子目录中，不会读取或删除 dir 中的其他文件
+func NewImageCache(dir string, maxSize int64, logger *zap.Logger) (*ImageCache, error) {
+	rootDir := filepath.Join(dir, imageCacheDir)
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return nil, err
+	}
 	cache := &ImageCache{
-		rootDir:         rootDir,
-		cleanupInterval: cleanupInterval,
-		maxAge:          maxAge,
+		rootDir: rootDir,
+		maxSize: maxSize,
+		logger:  logger,
+		entries: make(map[string]*list.Element[*cacheEntry]),
+		lru:     list.New[*cacheEntry](),
 	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	cache.startAutoClean(ctx)
-
-	return cache, cancel
+	if err := cache.index(); err != nil {
+		return nil, err
+	}
+	return cache, nil
 }
 
 func (c *ImageCache) GetPath(key string) string {
-	return filepath.Join(c.rootDir, key)
+	return filepath.Join(c.rootDir, filepath.FromSlash(key))
 }
 
-func (c *ImageCache) Exists(key string) bool {
+// Lookup 返回第一个存在的缓存项的路径，多个键是同一缓存项的候选，只记录一次命中或未命中
+func (c *ImageCache) Lookup(keys ...string) (string, bool) {
+	for _, key := range keys {
+		if c.touch(key) {
+			c.mu.Lock()
+			c.hits++
+			c.mu.Unlock()
+			return c.GetPath(key), true
+		}
+	}
+	c.mu.Lock()
+	c.misses++
+	c.mu.Unlock()
+	return "", false
+}
+
+// touch 检查缓存项是否存在并记录为最近使用
+func (c *ImageCache) touch(key string) bool {
+	c.mu.Lock()
+	_, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok {
+		return false
+	}
+
 	path := c.GetPath(key)
-	info, err := os.Stat(path)
-	if err != nil {
+	if _, err := os.Stat(path); err != nil {
+		// 被外部删除
+		c.mu.Lock()
+		c.remove(key)
+		c.mu.Unlock()
 		return false
 	}
-	// Update access/mod time to prevent cleanup
+	// 更新修改时间，重启后按修改时间恢复使用顺序
 	now := time.Now()
 	os.Chtimes(path, now, now)
-	return !info.IsDir()
-}
 
-func (c *ImageCache) startAutoClean(ctx context.Context) {
-	ticker := time.NewTicker(c.cleanupInterval)
-	go func() {
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				c.cleanup()
-			}
-		}
-	}()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		// 检查文件期间被淘汰
+		return false
+	}
+	c.lru.MoveToFront(el)
+	return true
 }
 
-func (c *ImageCache) cleanup() {
-	entries, err := os.ReadDir(c.rootDir)
+func (c *ImageCache) Save(key string, write func(file *os.File) error) (string, error) {
+	path := c.GetPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := util.AtomicSave(path, write); err != nil {
+		return "", err
+	}
+	info, err := os.Stat(path)
 	if err != nil {
-		log.Printf("Failed to read cache dir: %v", err)
-		return
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.add(key, info.Size())
+	c.evict()
+	return path, nil
+}
+
+// Stats 返回缓存统计
+func (c *ImageCache) Stats() *shared.ImageCacheStatsDTO {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &shared.ImageCacheStatsDTO{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Count:   c.lru.Len(),
+		Size:    c.size,
+		MaxSize: c.maxSize,
+	}
+}
+
+// Purge 删除命名空间下的缓存，namespace 为空时删除全部缓存，返回删除的缓存项数量
+func (c *ImageCache) Purge(namespace string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var count int
+	for key := range c.entries {
+		if namespace == "" || strings.HasPrefix(key, namespace+"/") {
+			c.remove(key)
+			count++
+		}
 	}
 
-	threshold := time.Now().Add(-c.maxAge)
+	// 同时删除缓存子目录中未被索引的文件（例如生成中的临时文件）
+	if namespace != "" {
+		return count, os.RemoveAll(c.GetPath(namespace))
+	}
+	dirEntries, err := os.ReadDir(c.rootDir)
+	if err != nil {
+		return count, err
+	}
+	for _, entry := range dirEntries {
+		if err := os.RemoveAll(filepath.Join(c.rootDir, entry.Name())); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
 
-	for _, entry := range entries {
-		info, err := entry.Info()
+// index 索引已有的缓存文件，按修改时间恢复使用顺序，并清理中断写入留下的临时文件
+func (c *ImageCache) index() error {
+	type indexedFile struct {
+		key     string
+		size    int64
+		modTime time.Time
+	}
+	var files []indexedFile
+	err := filepath.WalkDir(c.rootDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if strings.Contains(d.Name(), "~") {
+			// 缓存子目录中 AtomicSave 的临时文件或备份
+			if err := os.Remove(p); err != nil {
+				c.logger.Warn("failed to remove temporary cache file", zap.String("path", p), zap.Error(err))
+			}
+			return nil
+		}
+		info, err := d.Info()
 		if err != nil {
-			continue
+			return nil
 		}
-		if info.ModTime().Before(threshold) {
-			path := filepath.Join(c.rootDir, entry.Name())
-			if err := os.Remove(path); err != nil {
-				log.Printf("Failed to remove old cache file %s: %v", path, err)
+		rel, err := filepath.Rel(c.rootDir, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, indexedFile{filepath.ToSlash(rel), info.Size(), info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 删除空的命名空间目录，运行中不删除，避免与写入竞争
+	if dirEntries, err := os.ReadDir(c.rootDir); err == nil {
+		for _, entry := range dirEntries {
+			if entry.IsDir() {
+				// 非空时会失败，忽略错误
+				os.Remove(filepath.Join(c.rootDir, entry.Name()))
 			}
 		}
 	}
+
+	slices.SortFunc(files, func(a, b indexedFile) int {
+		return a.modTime.Compare(b.modTime)
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, f := range files {
+		c.add(f.key, f.size)
+	}
+	c.evict()
+	c.logger.Info("image cache indexed",
+		zap.String("dir", c.rootDir),
+		zap.Int("count", c.lru.Len()),
+		zap.Int64("size", c.size),
+	)
+	return nil
+}
+
+// add 记录缓存项为最近使用，调用方需持有锁
+func (c *ImageCache) add(key string, size int64) {
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value
+		c.size += size - entry.size
+		entry.size = size
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, size: size})
+	c.size += size
+}
+
+// evict 淘汰最近最少使用的缓存项直到不超过上限，保留最近使用的一项，调用方需持有锁
+func (c *ImageCache) evict() {
+	if c.maxSize <= 0 {
+		return
+	}
+	for c.size > c.maxSize && c.lru.Len() > 1 {
+		entry := c.lru.Back().Value
+		c.remove(entry.key)
+	}
+}
+
+// remove 删除缓存项及其文件，调用方需持有锁
+func (c *ImageCache) remove(key string) {
+	el, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	entry := el.Value
+	c.lru.Remove(el)
+	delete(c.entries, key)
+	c.size -= entry.size
+
+	p := c.GetPath(key)
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		c.logger.Warn("failed to remove cache file", zap.String("path", p), zap.Error(err))
+	}
 }
 
 var _ appimage.Cache = (*ImageCache)(nil)
@@ -0,0 +1,118 @@
+package localfs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func saveCacheEntry(t *testing.T, cache *ImageCache, key string, size int) string {
+	path, err := cache.Save(key, func(f *os.File) error {
+		_, err := f.WriteString(strings.Repeat("x", size))
+		return err
+	})
+	require.NoError(t, err)
+	return path
+}
+
+func cacheExists(cache *ImageCache, key string) bool {
+	_, ok := cache.Lookup(key)
+	return ok
+}
+
+func TestImageCache_EvictLeastRecentlyUsed(t *testing.T) {
+	cache, err := NewImageCache(t.TempDir(), 250, zap.NewNop())
+	require.NoError(t, err)
+
+	a := saveCacheEntry(t, cache, "src1/a", 100)
+	saveCacheEntry(t, cache, "src1/b", 100)
+	// 访问 a 后 b 成为最久未使用
+	assert.True(t, cacheExists(cache, "src1/a"))
+	saveCacheEntry(t, cache, "src2/c", 100)
+
+	assert.True(t, cacheExists(cache, "src1/a"))
+	assert.False(t, cacheExists(cache, "src1/b"))
+	assert.True(t, cacheExists(cache, "src2/c"))
+	assert.FileExists(t, a)
+	assert.NoFileExists(t, cache.GetPath("src1/b"))
+
+	stats := cache.Stats()
+	assert.Equal(t, 2, stats.Count)
+	assert.Equal(t, int64(200), stats.Size)
+	// 只统计查找，保存不计入未命中
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.Equal(t, int64(3), stats.Hits)
+}
+
+func TestImageCache_Lookup(t *testing.T) {
+	cache, err := NewImageCache(t.TempDir(), 0, zap.NewNop())
+	require.NoError(t, err)
+	png := saveCacheEntry(t, cache, "src1/a.png", 10)
+
+	// 候选的键只记录一次命中或未命中
+	path, ok := cache.Lookup("src1/a.jpg", "src1/a.png")
+	assert.True(t, ok)
+	assert.Equal(t, png, path)
+	_, ok = cache.Lookup("src1/b.jpg", "src1/b.png")
+	assert.False(t, ok)
+
+	stats := cache.Stats()
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(1), stats.Misses)
+}
+
+func TestImageCache_Index(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewImageCache(dir, 0, zap.NewNop())
+	require.NoError(t, err)
+	old := saveCacheEntry(t, cache, "src1/old", 100)
+	saveCacheEntry(t, cache, "src1/new", 100)
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(old, past, past))
+	// 中断写入留下的临时文件
+	tmp := cache.GetPath("src1/new~123.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte("x"), 0644))
+
+	cache, err = NewImageCache(dir, 150, zap.NewNop())
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, tmp)
+	assert.False(t, cacheExists(cache, "src1/old"), "older entry should be evicted first")
+	assert.True(t, cacheExists(cache, "src1/new"))
+	assert.Equal(t, int64(100), cache.Stats().Size)
+}
+
+func TestImageCache_Purge(t *testing.T) {
+	dir := t.TempDir()
+	// 缓存目录中的其他文件不属于缓存
+	other := filepath.Join(dir, "other", "a~")
+	require.NoError(t, os.MkdirAll(filepath.Dir(other), 0755))
+	require.NoError(t, os.WriteFile(other, []byte("x"), 0644))
+
+	cache, err := NewImageCache(dir, 0, zap.NewNop())
+	require.NoError(t, err)
+	assert.FileExists(t, other, "index should not touch files outside the cache")
+	saveCacheEntry(t, cache, "src1/a", 10)
+	saveCacheEntry(t, cache, "src1/b", 10)
+	saveCacheEntry(t, cache, "src2/c", 10)
+
+	count, err := cache.Purge("src1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NoDirExists(t, cache.GetPath("src1"))
+	assert.True(t, cacheExists(cache, "src2/c"))
+
+	count, err = cache.Purge("")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 0, cache.Stats().Count)
+	assert.Equal(t, int64(0), cache.Stats().Size)
+	assert.NoDirExists(t, cache.GetPath("src2"))
+	assert.FileExists(t, other)
+}
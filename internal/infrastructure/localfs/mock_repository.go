@@ -14,6 +14,10 @@ func (m *mockMetadataRepository) Read(imagePath string) (*metadata.XMPData, erro
 	return nil, nil
 }
 
-func (m *mockMetadataRepository) Write(imagePath string, data *metadata.XMPData) error {
+func (m *mockMetadataRepository) Write(imagePath string, data *metadata.XMPData, options ...metadata.WriteOption) error {
 	return nil
 }
+
+func (m *mockMetadataRepository) Revision(imagePath string) (string, error) {
+	return "", nil
+}
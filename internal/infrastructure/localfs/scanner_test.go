@@ -2,20 +2,28 @@ package localfs
 
 import (
 	"context"
+	"errors"
 	"iter"
 	"main/internal/domain/directory"
 	domainimage "main/internal/domain/image"
+	"main/internal/domain/metadata"
+	"main/internal/infrastructure/boltdb"
 	"main/internal/infrastructure/inmem"
+	"main/internal/infrastructure/xmpsidecar"
+	"main/internal/shared"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func newTestScanner(t *testing.T) *Scanner {
-	factory := domainimage.NewFactory(newMockMetadataRepository(), nil)
+	factory := domainimage.NewFactory(newMockMetadataRepository(), nil, shared.NewDefaultFormatRegistry())
 	rootDir := t.TempDir()
 	dirRepo := inmem.NewDirectoryRepository(rootDir)
 	return NewScanner(rootDir, factory, dirRepo)
@@ -39,6 +47,185 @@ func TestScan(t *testing.T) {
 	assert.Equal(t, "test.jpg", images[0].Filename())
 }
 
+func TestScan_BrokenSidecar(t *testing.T) {
+	rootDir := t.TempDir()
+	factory := domainimage.NewFactory(xmpsidecar.NewRepository(), nil, shared.NewDefaultFormatRegistry())
+	scanner := NewScanner(rootDir, factory, inmem.NewDirectoryRepository(rootDir))
+
+	testFile := filepath.Join(rootDir, "test.jpg")
+	require.NoError(t, os.WriteFile(testFile, []byte("test"), 0644))
+	require.NoError(t, os.WriteFile(testFile+".xmp", []byte("<x:xmpmeta"), 0644))
+
+	images := collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1, "Image with broken sidecar should not be omitted")
+	assert.Error(t, images[0].XMPError())
+}
+
+type countingMetaProcessor struct {
+	calls atomic.Int32
+}
+
+func (p *countingMetaProcessor) Meta(ctx context.Context, path string) (*shared.ImageMeta, error) {
+	p.calls.Add(1)
+	return &shared.ImageMeta{Width: 30, Height: 20}, nil
+}
+
+func TestScan_Index(t *testing.T) {
+	rootDir := t.TempDir()
+	index, err := boltdb.OpenImageIndex(filepath.Join(t.TempDir(), "index.db"), time.Second, zap.NewNop())
+	require.NoError(t, err)
+	defer index.Close()
+
+	metadataRepo := xmpsidecar.NewRepository()
+	processor := &countingMetaProcessor{}
+	factory := domainimage.NewFactory(metadataRepo, processor, shared.NewDefaultFormatRegistry(), domainimage.WithIndex(index))
+	scanner := NewScanner(rootDir, factory, inmem.NewDirectoryRepository(rootDir))
+
+	testFile := filepath.Join(rootDir, "test.jpg")
+	require.NoError(t, os.WriteFile(testFile, []byte("test"), 0644))
+
+	images := collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1)
+	assert.EqualValues(t, 0, processor.calls.Load(), "scan should not probe dimensions")
+	assert.Equal(t, 30, images[0].Width())
+	assert.EqualValues(t, 1, processor.calls.Load())
+
+	// 文件未变化时使用索引
+	images = collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1)
+	assert.Equal(t, 30, images[0].Width())
+	assert.EqualValues(t, 1, processor.calls.Load())
+
+	// sidecar 变化时只重新读取 sidecar
+	require.NoError(t, metadataRepo.Write(testFile, metadata.NewXMPData(4, "KEEP", time.Now())))
+	images = collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1)
+	assert.Equal(t, 4, images[0].Rating())
+	assert.EqualValues(t, 1, processor.calls.Load())
+
+	// 图片变化时重新探测
+	require.NoError(t, os.WriteFile(testFile, []byte("changed"), 0644))
+	images = collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1)
+	assert.Equal(t, 4, images[0].Rating())
+	assert.Equal(t, 30, images[0].Width())
+	assert.EqualValues(t, 2, processor.calls.Load())
+}
+
+func TestScan_LazyMeta(t *testing.T) {
+	rootDir := t.TempDir()
+	processor := &countingMetaProcessor{}
+	factory := domainimage.NewFactory(newMockMetadataRepository(), processor, shared.NewDefaultFormatRegistry())
+	scanner := NewScanner(rootDir, factory, inmem.NewDirectoryRepository(rootDir))
+
+	for _, name := range []string{"a.jpg", "b.jpg", "c.jpg"} {
+		require.NoError(t, os.WriteFile(filepath.Join(rootDir, name), []byte("test"), 0644))
+	}
+
+	images := collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 3)
+	assert.EqualValues(t, 0, processor.calls.Load())
+	assert.False(t, images[0].MetaResolved())
+
+	domainimage.ResolveMeta(images)
+	assert.EqualValues(t, 3, processor.calls.Load())
+	for _, img := range images {
+		assert.True(t, img.MetaResolved())
+		assert.Equal(t, 30, img.Width())
+		assert.Equal(t, 20, img.Height())
+	}
+	// 副本共享探测结果
+	copied := images[0].WithXMPData(metadata.NewXMPData(3, "", time.Now(), metadata.WithOrientation(6)))
+	assert.Equal(t, 20, copied.Width())
+	assert.EqualValues(t, 3, processor.calls.Load())
+}
+
+// flakyMetaProcessor 前 failures 次探测失败
+type flakyMetaProcessor struct {
+	countingMetaProcessor
+	failures int32
+}
+
+func (p *flakyMetaProcessor) Meta(ctx context.Context, path string) (*shared.ImageMeta, error) {
+	if p.calls.Load() < p.failures {
+		p.calls.Add(1)
+		return nil, errors.New("probe failed")
+	}
+	return p.countingMetaProcessor.Meta(ctx, path)
+}
+
+func TestScan_LazyMeta_RetryAfterFailure(t *testing.T) {
+	rootDir := t.TempDir()
+	processor := &flakyMetaProcessor{failures: 1}
+	factory := domainimage.NewFactory(newMockMetadataRepository(), processor, shared.NewDefaultFormatRegistry())
+	scanner := NewScanner(rootDir, factory, inmem.NewDirectoryRepository(rootDir))
+	testFile := filepath.Join(rootDir, "a.jpg")
+	require.NoError(t, os.WriteFile(testFile, []byte("test"), 0644))
+
+	images := collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1)
+
+	// 探测失败时尺寸为 0
+	assert.Equal(t, 0, images[0].Width())
+	assert.False(t, images[0].MetaResolved())
+	assert.EqualValues(t, 1, processor.calls.Load())
+
+	// 文件未变化时不重复探测，重新扫描创建的图片也使用记录的失败
+	assert.Equal(t, 0, images[0].Height())
+	images = collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1)
+	assert.Equal(t, 0, images[0].Width())
+	assert.EqualValues(t, 1, processor.calls.Load())
+
+	// 文件变化后重新探测
+	require.NoError(t, os.WriteFile(testFile, []byte("changed"), 0644))
+	images = collectImages(scanner.Scan(context.Background(), "."))
+	require.Len(t, images, 1)
+	assert.Equal(t, 30, images[0].Width())
+	assert.True(t, images[0].MetaResolved())
+	assert.EqualValues(t, 2, processor.calls.Load())
+
+	assert.Equal(t, 20, images[0].Height())
+	assert.EqualValues(t, 2, processor.calls.Load())
+}
+
+func TestScan_ContentIdentity(t *testing.T) {
+	rootDir := t.TempDir()
+	factory := domainimage.NewFactory(newMockMetadataRepository(), nil, shared.NewDefaultFormatRegistry(),
+		domainimage.WithFingerprinter(NewPartialFingerprinter()))
+	scanner := NewScanner(rootDir, factory, inmem.NewDirectoryRepository(rootDir))
+
+	testFile := filepath.Join(rootDir, "test.jpg")
+	require.NoError(t, os.WriteFile(testFile, []byte("test"), 0644))
+	img, err := scanner.LookupImage(context.Background(), "test.jpg")
+	require.NoError(t, err)
+
+	// 修改时间变化时 ID 不变
+	require.NoError(t, os.Chtimes(testFile, time.Now(), time.Now().Add(time.Hour)))
+	touched, err := scanner.LookupImage(context.Background(), "test.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, img.ID(), touched.ID())
+
+	// 内容相同的副本是不同的图片，指纹相同
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "copy.jpg"), []byte("test"), 0644))
+	copied, err := scanner.LookupImage(context.Background(), "copy.jpg")
+	require.NoError(t, err)
+	assert.NotEqual(t, img.ID(), copied.ID())
+	assert.Equal(t, img.Fingerprint(), copied.Fingerprint())
+
+	// 重命名后指纹不变，由会话关联为移动
+	require.NoError(t, os.Rename(testFile, filepath.Join(rootDir, "renamed.jpg")))
+	renamed, err := scanner.LookupImage(context.Background(), "renamed.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, img.Fingerprint(), renamed.Fingerprint())
+
+	// 内容变化时 ID 改变
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "renamed.jpg"), []byte("changed"), 0644))
+	changed, err := scanner.LookupImage(context.Background(), "renamed.jpg")
+	require.NoError(t, err)
+	assert.NotEqual(t, renamed.ID(), changed.ID())
+}
+
 func TestScan_EmptyDirectory(t *testing.T) {
 	scanner := newTestScanner(t)
 
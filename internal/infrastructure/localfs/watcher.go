@@ -17,6 +17,65 @@ import (
 	"go.uber.org/zap"
 )
 
+// renameWindow 是重命名的旧路径等待配对新路径的时间
+//
+// inotify 的 MOVED_FROM 和 MOVED_TO 总是连续产生，很短的窗口即可
+const renameWindow = 100 * time.Millisecond
+
+// fileStat 是文件最近一次已知的状态，重命名不改变大小和修改时间，用于校验重命名配对
+type fileStat struct {
+	size    int64
+	modTime time.Time
+	isDir   bool
+}
+
+func newFileStat(info fs.FileInfo) fileStat {
+	return fileStat{size: info.Size(), modTime: info.ModTime(), isDir: info.IsDir()}
+}
+
+// knownFiles 记录监听目录中文件的最近状态（绝对路径 -> 状态）
+type knownFiles map[string]fileStat
+
+// update 重新读取 path 的状态，文件不存在时移除记录
+func (k knownFiles) update(path string) (fileStat, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		k.forget(path)
+		return fileStat{}, false
+	}
+	stat := newFileStat(info)
+	k[path] = stat
+	return stat, true
+}
+
+// forget 移除 path 及其下所有文件的记录
+func (k knownFiles) forget(path string) {
+	if stat, ok := k[path]; ok && !stat.isDir {
+		delete(k, path)
+		return
+	}
+	delete(k, path)
+	prefix := path + string(filepath.Separator)
+	for p := range k {
+		if strings.HasPrefix(p, prefix) {
+			delete(k, p)
+		}
+	}
+}
+
+// move 将 oldPath 及其下所有文件的记录迁移到 newPath
+func (k knownFiles) move(oldPath, newPath string) {
+	prefix := oldPath + string(filepath.Separator)
+	for p, stat := range k {
+		if strings.HasPrefix(p, prefix) {
+			delete(k, p)
+			k[filepath.Join(newPath, strings.TrimPrefix(p, prefix))] = stat
+		}
+	}
+	delete(k, oldPath)
+	k.update(newPath)
+}
+
 // Watcher 文件系统监控器
 type Watcher struct {
 	logger *zap.Logger
@@ -42,7 +101,8 @@ func (w *Watcher) Watch(ctx context.Context, dir string) iter.Seq2[*directory.Fi
 		}
 		defer fsWatcher.Close()
 
-		// 递归添加监控路径
+		// 递归添加监控路径，并记录文件状态
+		known := make(knownFiles)
 		err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
 			if err != nil {
 				// 如果是根目录无法访问，返回错误
@@ -58,6 +118,9 @@ func (w *Watcher) Watch(ctx context.Context, dir string) iter.Seq2[*directory.Fi
 					w.logger.Warn("failed to add watch path", zap.String("path", path), zap.Error(err))
 				}
 			}
+			if info, err := d.Info(); err == nil {
+				known[path] = newFileStat(info)
+			}
 			return nil
 		})
 
@@ -70,21 +133,59 @@ func (w *Watcher) Watch(ctx context.Context, dir string) iter.Seq2[*directory.Fi
 		w.logger.Info("started watching directory recursively", zap.String("dir", dir))
 
 		// 监听事件
+		// fsnotify 不提供 inotify 的 cookie，重命名的旧路径和新路径按时间窗口配对：
+		// 旧路径之后紧接着的创建，且大小和修改时间与旧路径最近的状态一致时视为重命名；
+		// 否则（如同时创建了其他文件）按删除和创建处理，超时未配对说明移出了监听目录，按删除处理
+		var pending *directory.FileChange
+		var pendingTimeout <-chan time.Time
+		flushPending := func() bool {
+			if pending == nil {
+				return true
+			}
+			known.forget(pending.AbsPath())
+			change := directory.NewFileChange(pending.AbsPath(), shared.FileActionRemove, pending.OccurredAt())
+			pending, pendingTimeout = nil, nil
+			return yield(change, nil)
+		}
 		for {
 			select {
 			case <-ctx.Done():
 				w.logger.Info("stopped watching directory", zap.String("dir", dir))
 				return
+			case <-pendingTimeout:
+				if !flushPending() {
+					return
+				}
 			case fsEvent, ok := <-fsWatcher.Events:
 				if !ok {
+					flushPending()
 					return
 				}
 
-				fileChange, ok := w.handleEvent(fsWatcher, fsEvent)
-				if ok {
-					if !yield(fileChange, nil) {
-						return
-					}
+				fileChange, ok := w.handleEvent(fsWatcher, known, fsEvent)
+				if !ok {
+					continue
+				}
+				if pending != nil && fileChange.Action() == shared.FileActionCreate && isRenamed(known, pending.AbsPath(), fileChange.AbsPath()) {
+					known.move(pending.AbsPath(), fileChange.AbsPath())
+					fileChange = directory.NewRenameFileChange(pending.AbsPath(), fileChange.AbsPath(), fileChange.OccurredAt())
+					pending, pendingTimeout = nil, nil
+				} else if !flushPending() {
+					return
+				}
+				switch fileChange.Action() {
+				case shared.FileActionCreate, shared.FileActionWrite:
+					known.update(fileChange.AbsPath())
+				case shared.FileActionRemove:
+					known.forget(fileChange.AbsPath())
+				}
+				if fileChange.Action() == shared.FileActionRename && fileChange.OldAbsPath() == "" {
+					pending = fileChange
+					pendingTimeout = time.After(renameWindow)
+					continue
+				}
+				if !yield(fileChange, nil) {
+					return
 				}
 			case err, ok := <-fsWatcher.Errors:
 				if !ok {
@@ -99,8 +200,22 @@ func (w *Watcher) Watch(ctx context.Context, dir string) iter.Seq2[*directory.Fi
 	}
 }
 
+// isRenamed 判断 newPath 是否是 oldPath 重命名后的文件：两者的大小、修改时间和类型一致
+func isRenamed(known knownFiles, oldPath, newPath string) bool {
+	old, ok := known[oldPath]
+	if !ok {
+		return false
+	}
+	info, err := os.Stat(newPath)
+	if err != nil {
+		return false
+	}
+	current := newFileStat(info)
+	return current.isDir == old.isDir && current.size == old.size && current.modTime.Equal(old.modTime)
+}
+
 // handleEvent 处理文件系统事件，转换为领域对象
-func (w *Watcher) handleEvent(fsWatcher *fsnotify.Watcher, fsEvent fsnotify.Event) (*directory.FileChange, bool) {
+func (w *Watcher) handleEvent(fsWatcher *fsnotify.Watcher, known knownFiles, fsEvent fsnotify.Event) (*directory.FileChange, bool) {
 	// 自动监控新建的子目录或重命名的目录
 	if fsEvent.Op&fsnotify.Create == fsnotify.Create || fsEvent.Op&fsnotify.Rename == fsnotify.Rename {
 		info, err := os.Stat(fsEvent.Name)
@@ -119,6 +234,10 @@ func (w *Watcher) handleEvent(fsWatcher *fsnotify.Watcher, fsEvent fsnotify.Even
 						w.logger.Warn("failed to add watch path", zap.String("path", path), zap.Error(err))
 					}
 				}
+				// 目录本身的状态在配对重命名之后更新
+				if info, err := d.Info(); err == nil && path != fsEvent.Name {
+					known[path] = newFileStat(info)
+				}
 				return nil
 			})
 			if err != nil {
@@ -0,0 +1,133 @@
+//go:build !windows
+
+package localfs
+
+import (
+	"context"
+	"main/internal/domain/directory"
+	"main/internal/shared"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// startWatch 开始监听 dir，返回接收变更的通道
+func startWatch(t *testing.T, dir string) <-chan *directory.FileChange {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	changes := make(chan *directory.FileChange, 16)
+	go func() {
+		defer close(changes)
+		for change, err := range NewWatcher(zap.NewNop()).Watch(ctx, dir) {
+			if err != nil {
+				continue
+			}
+			changes <- change
+		}
+	}()
+	// 等待监听路径添加完成
+	time.Sleep(100 * time.Millisecond)
+	return changes
+}
+
+// nextChange 返回下一个非写入的变更
+func nextChange(t *testing.T, changes <-chan *directory.FileChange) *directory.FileChange {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case change, ok := <-changes:
+			require.True(t, ok, "watcher stopped")
+			if change.Action() == shared.FileActionWrite {
+				continue
+			}
+			return change
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for file change")
+			return nil
+		}
+	}
+}
+
+func writeOldFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, old, old))
+}
+
+func TestWatch_RenamePairing(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(t *testing.T, dir, outside string)
+		want   []*directory.FileChange
+	}{
+		{
+			name: "rename within watched directory",
+			mutate: func(t *testing.T, dir, _ string) {
+				require.NoError(t, os.Rename(filepath.Join(dir, "a.jpg"), filepath.Join(dir, "b.jpg")))
+			},
+			want: []*directory.FileChange{
+				directory.NewRenameFileChange("a.jpg", "b.jpg", time.Time{}),
+			},
+		},
+		{
+			name: "move directory into another watched directory",
+			mutate: func(t *testing.T, dir, _ string) {
+				require.NoError(t, os.Rename(filepath.Join(dir, "sub"), filepath.Join(dir, "other", "sub")))
+			},
+			want: []*directory.FileChange{
+				directory.NewRenameFileChange("sub", filepath.Join("other", "sub"), time.Time{}),
+			},
+		},
+		{
+			name: "move out of watched directory",
+			mutate: func(t *testing.T, dir, outside string) {
+				require.NoError(t, os.Rename(filepath.Join(dir, "a.jpg"), filepath.Join(outside, "a.jpg")))
+			},
+			want: []*directory.FileChange{
+				directory.NewFileChange("a.jpg", shared.FileActionRemove, time.Time{}),
+			},
+		},
+		{
+			name: "unrelated create after move out",
+			mutate: func(t *testing.T, dir, outside string) {
+				require.NoError(t, os.Rename(filepath.Join(dir, "a.jpg"), filepath.Join(outside, "a.jpg")))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "c.jpg"), []byte("unrelated"), 0644))
+			},
+			want: []*directory.FileChange{
+				directory.NewFileChange("a.jpg", shared.FileActionRemove, time.Time{}),
+				directory.NewFileChange("c.jpg", shared.FileActionCreate, time.Time{}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			outside := t.TempDir()
+			writeOldFile(t, filepath.Join(dir, "a.jpg"), "test")
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "other"), 0755))
+			writeOldFile(t, filepath.Join(dir, "sub", "d.jpg"), "test")
+
+			changes := startWatch(t, dir)
+			tc.mutate(t, dir, outside)
+
+			for _, want := range tc.want {
+				got := nextChange(t, changes)
+				assert.Equal(t, want.Action(), got.Action())
+				assert.Equal(t, filepath.Join(dir, want.AbsPath()), got.AbsPath())
+				if want.OldAbsPath() != "" {
+					assert.Equal(t, filepath.Join(dir, want.OldAbsPath()), got.OldAbsPath())
+				}
+			}
+		})
+	}
+}
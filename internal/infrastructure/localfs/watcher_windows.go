@@ -107,6 +107,8 @@ func (w *Watcher) Watch(ctx context.Context, dir string) iter.Seq2[*directory.Fi
 			}
 
 			offset := uint32(0)
+			// 重命名的旧路径，Windows 总是紧接着产生新路径的通知
+			var renamedFrom string
 			for {
 				if offset+12 > ret {
 					break // 数据不足以解析 FILE_NOTIFY_INFORMATION 头部
@@ -119,35 +121,45 @@ func (w *Watcher) Watch(ctx context.Context, dir string) iter.Seq2[*directory.Fi
 
 				namePtr := (*[0xffff]uint16)(unsafe.Pointer(&info.FileName))[:nameLen:nameLen]
 				name := windows.UTF16ToString(namePtr)
+				absPath := filepath.Join(dir, name)
 
 				// 忽略临时文件
-				if !strings.HasSuffix(name, ".tmp") {
-					var action shared.FileAction
-					switch info.Action {
-					case windows.FILE_ACTION_ADDED, windows.FILE_ACTION_RENAMED_NEW_NAME:
-						action = shared.FileActionCreate
-					case windows.FILE_ACTION_MODIFIED:
-						action = shared.FileActionWrite
-					case windows.FILE_ACTION_REMOVED:
-						action = shared.FileActionRemove
-					case windows.FILE_ACTION_RENAMED_OLD_NAME:
-						action = shared.FileActionRename // 重命名的旧文件，按照规范处理为 Rename，等价于 Remove
-					default:
-						goto next
-					}
-
-					absPath := filepath.Join(dir, name)
-					if !yield(directory.NewFileChange(absPath, action, time.Now()), nil) {
-						return
+				ignored := strings.HasSuffix(name, ".tmp")
+				var change *directory.FileChange
+				switch {
+				case info.Action == windows.FILE_ACTION_RENAMED_NEW_NAME && renamedFrom != "":
+					if ignored {
+						// 重命名为临时文件，按删除处理
+						change = directory.NewFileChange(renamedFrom, shared.FileActionRemove, time.Now())
+					} else {
+						change = directory.NewRenameFileChange(renamedFrom, absPath, time.Now())
 					}
+					renamedFrom = ""
+				case ignored:
+				case info.Action == windows.FILE_ACTION_ADDED, info.Action == windows.FILE_ACTION_RENAMED_NEW_NAME:
+					change = directory.NewFileChange(absPath, shared.FileActionCreate, time.Now())
+				case info.Action == windows.FILE_ACTION_MODIFIED:
+					change = directory.NewFileChange(absPath, shared.FileActionWrite, time.Now())
+				case info.Action == windows.FILE_ACTION_REMOVED:
+					change = directory.NewFileChange(absPath, shared.FileActionRemove, time.Now())
+				case info.Action == windows.FILE_ACTION_RENAMED_OLD_NAME:
+					renamedFrom = absPath
+				}
+				if change != nil && !yield(change, nil) {
+					return
 				}
 
-			next:
 				if info.NextEntryOffset == 0 {
 					break
 				}
 				offset += info.NextEntryOffset
 			}
+			// 没有配对的新路径（如重命名为临时文件），按删除处理
+			if renamedFrom != "" {
+				if !yield(directory.NewFileChange(renamedFrom, shared.FileActionRemove, time.Now()), nil) {
+					return
+				}
+			}
 		}
 	}
 }
@@ -4,41 +4,66 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
-	"encoding/base64"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sync"
 
 	appimage "main/internal/application/image"
+	"main/internal/infrastructure/concurrency"
+	"main/internal/infrastructure/icc"
 	"main/internal/shared"
 	"main/internal/util"
-
-	"golang.org/x/sync/semaphore"
 )
 
+// Processor 调用 ImageMagick 生成预览图
+//
+// 输出按 EXIF 方向旋转，嵌入的 ICC 配置文件转换为 sRGB
 type Processor struct {
-	cache appimage.Cache
-	sem   *semaphore.Weighted
+	cache         appimage.Cache
+	keyer         appimage.CacheKeyer
+	formats       *shared.FormatRegistry
+	scheduler     *concurrency.PriorityScheduler
+	stripMetadata bool
+}
+
+// ProcessorOption 用于配置 Processor
+type ProcessorOption func(*Processor)
+
+// WithStripMetadata 设置是否删除预览图中的元数据（EXIF、XMP、ICC 配置文件等）
+func WithStripMetadata(strip bool) ProcessorOption {
+	return func(p *Processor) {
+		p.stripMetadata = strip
+	}
 }
 
-func NewProcessor(cache appimage.Cache, concurrency int64) *Processor {
-	if concurrency <= 0 {
-		concurrency = 4
+func NewProcessor(cache appimage.Cache, keyer appimage.CacheKeyer, formats *shared.FormatRegistry, limit int64, options ...ProcessorOption) *Processor {
+	if limit <= 0 {
+		limit = 4
 	}
-	return &Processor{
-		cache: cache,
-		sem:   semaphore.NewWeighted(concurrency), // 限制并发处理数量，防止大图片导致内存溢出
+	p := &Processor{
+		cache:   cache,
+		keyer:   keyer,
+		formats: formats,
+		// 限制并发处理数量，防止大图片导致内存溢出，空闲名额优先分配给正在查看的图片
+		scheduler: concurrency.NewPriorityScheduler(limit, concurrency.DefaultPriorityAging),
 	}
+	for _, opt := range options {
+		opt(p)
+	}
+	return p
 }
 
-func (p *Processor) Process(ctx context.Context, srcPath string, width, quality int) (string, error) {
-	info, err := os.Stat(srcPath)
-	if err != nil {
-		return "", err
+func (p *Processor) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
+	if format.IsZero() {
+		format = shared.ImageFormatWebP
+	}
+	coder, ok := coders[format]
+	if !ok {
+		return "", fmt.Errorf("unsupported output format: %s", format)
 	}
 
-	timestamp := fmt.Sprintf("%d", info.ModTime().Unix())
-	size := fmt.Sprintf("%d", info.Size())
 	wStr := ""
 	if width > 0 {
 		wStr = fmt.Sprintf("%d", width)
@@ -48,33 +73,63 @@ func (p *Processor) Process(ctx context.Context, srcPath string, width, quality
 		qStr = fmt.Sprintf("%d", quality)
 	}
 
-	hash := sha256.New()
-	fmt.Fprintf(hash, "%s|%s|%s|%s|%s", srcPath, timestamp, size, wStr, qStr)
+	// 多帧图片（动画、多页 TIFF 等）默认只使用第一帧
+	animated := p.formats.KeepAnimation(srcPath, format)
 
-	cacheKey := base64.URLEncoding.EncodeToString(hash.Sum(nil))
+	params := fmt.Sprintf("magick|%s|%s|%s|%t|srgb|%t", wStr, qStr, format, animated, p.stripMetadata)
+	orientation := appimage.OrientationFromContext(ctx)
+	if orientation != 0 {
+		params += fmt.Sprintf("|orientation|%d", orientation)
+	}
+	cacheKey, err := p.keyer.Key(srcPath, params)
+	if err != nil {
+		return "", err
+	}
+	cacheKey += format.Meta().Extension
 
-	if p.cache.Exists(cacheKey) {
-		return p.cache.GetPath(cacheKey), nil
+	if path, ok := p.cache.Lookup(cacheKey); ok {
+		return path, nil
 	}
 
-	cachePath := p.cache.GetPath(cacheKey)
+	profile, err := srgbProfilePath()
+	if err != nil {
+		return "", err
+	}
 
-	// Acquire semaphore to limit concurrency
-	if err := p.sem.Acquire(ctx, 1); err != nil {
+	// Acquire scheduler slot to limit concurrency
+	if err := p.scheduler.Acquire(ctx); err != nil {
 		return "", err
 	}
-	defer p.sem.Release(1)
+	defer p.scheduler.Release()
 
-	// Use AtomicSave to write the file securely
-	err = util.AtomicSave(cachePath, func(f *os.File) error {
-		args := []string{srcPath, "-coalesce"}
+	// Write the file atomically through the cache
+	return p.cache.Save(cacheKey, func(f *os.File) error {
+		input := srcPath
+		if !animated {
+			input += "[0]"
+		}
+		// 先旋转再缩放，宽度限制作用于显示宽度。
+		// 指定 sRGB 配置文件时，带有其他配置文件的图片会被转换，没有配置文件的图片视为 sRGB
+		args := []string{input, "-coalesce"}
+		if orientation != 0 {
+			// 覆盖原图中的方向
+			args = append(args, "-orient", orientationNames[orientation-1])
+		}
+		args = append(args, "-auto-orient", "-profile", profile)
+		if p.stripMetadata {
+			args = append(args, "-strip")
+		}
 		if width > 0 {
 			args = append(args, "-resize", fmt.Sprintf("%dx>", width))
 		}
 		if quality > 0 {
 			args = append(args, "-quality", fmt.Sprintf("%d", quality))
 		}
-		args = append(args, "webp:-")
+		if format == shared.ImageFormatJPEG {
+			// JPEG 不支持透明度，合成到白色背景
+			args = append(args, "-background", "white", "-alpha", "remove")
+		}
+		args = append(args, coder+":-")
 
 		cmd := exec.CommandContext(ctx, "magick", args...)
 		cmd.Stdout = f // Write directly to the temp file
@@ -91,32 +146,78 @@ func (p *Processor) Process(ctx context.Context, srcPath string, width, quality
 		}
 		return nil
 	})
+}
 
-	return cachePath, err
+// coders 是输出格式对应的 ImageMagick 编码器
+var coders = map[shared.ImageFormat]string{
+	shared.ImageFormatAVIF: "avif",
+	shared.ImageFormatWebP: "webp",
+	shared.ImageFormatJPEG: "jpeg",
+	shared.ImageFormatPNG:  "png",
 }
 
 func (p *Processor) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
-	if err := p.sem.Acquire(ctx, 1); err != nil {
+	if err := p.scheduler.Acquire(ctx); err != nil {
 		return nil, err
 	}
-	defer p.sem.Release(1)
+	defer p.scheduler.Release()
 
-	cmd := exec.CommandContext(ctx, "magick", "identify", "-ping", "-format", "%w %h", srcPath)
+	// 多帧图片会输出每一帧的尺寸，只读取第一帧
+	cmd := exec.CommandContext(ctx, "magick", "identify", "-ping", "-format", "%w %h %[orientation]", srcPath+"[0]")
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get image metadata: %w", err)
 	}
+	return parseIdentifyOutput(string(output))
+}
 
+// parseIdentifyOutput 解析 identify 输出的尺寸和方向，返回按方向旋转后的显示尺寸
+func parseIdentifyOutput(output string) (*shared.ImageMeta, error) {
 	var width, height int
-	_, err = fmt.Sscanf(string(output), "%d %d", &width, &height)
-	if err != nil {
+	var orientation string
+	n, err := fmt.Sscanf(output, "%d %d %s", &width, &height, &orientation)
+	if n < 2 {
 		return nil, fmt.Errorf("failed to parse image dimensions: %w", err)
 	}
 
-	return &shared.ImageMeta{
+	meta := &shared.ImageMeta{
 		Width:  width,
 		Height: height,
-	}, nil
+	}
+	for i, name := range orientationNames {
+		if name == orientation {
+			meta.Orientation = i + 1
+		}
+	}
+	// LeftTop、RightTop、RightBottom、LeftBottom 需要旋转 90 度显示
+	if meta.Orientation >= 5 {
+		meta.Width, meta.Height = height, width
+	}
+	return meta, nil
+}
+
+// orientationNames 是 EXIF 方向 1-8 对应的 ImageMagick 名称
+var orientationNames = [...]string{
+	"TopLeft", "TopRight", "BottomRight", "BottomLeft",
+	"LeftTop", "RightTop", "RightBottom", "LeftBottom",
 }
 
+// srgbProfilePath 返回写入临时目录的 sRGB 配置文件路径，作为 -profile 的转换目标
+var srgbProfilePath = sync.OnceValues(func() (string, error) {
+	data := icc.SRGB()
+	sum := sha256.Sum256(data)
+	path := filepath.Join(os.TempDir(), fmt.Sprintf("image-funnel-srgb-%x.icc", sum[:4]))
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	err := util.AtomicSave(path, func(f *os.File) error {
+		_, err := f.Write(data)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to write sRGB profile: %w", err)
+	}
+	return path, nil
+})
+
 var _ appimage.Processor = (*Processor)(nil)
@@ -2,8 +2,13 @@ package magick
 
 import (
 	"context"
+	"errors"
+	"os"
 	"testing"
 
+	"main/internal/infrastructure/localfs"
+	"main/internal/shared"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,43 +20,71 @@ func (m *mockCache) GetPath(key string) string {
 	return m.paths[key]
 }
 
-func (m *mockCache) Exists(key string) bool {
-	_, ok := m.paths[key]
-	return ok
+func (m *mockCache) Lookup(keys ...string) (string, bool) {
+	for _, key := range keys {
+		if path, ok := m.paths[key]; ok {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func (m *mockCache) Save(key string, write func(file *os.File) error) (string, error) {
+	return "", errors.New("not implemented")
 }
 
 func TestNewProcessor(t *testing.T) {
 	cache := &mockCache{}
-	p := NewProcessor(cache, 4)
+	p := NewProcessor(cache, localfs.NewPathCacheKeyer(), shared.NewDefaultFormatRegistry(), 4)
 	assert.NotNil(t, p)
-	assert.NotNil(t, p.sem)
+	assert.NotNil(t, p.scheduler)
 }
 
-func TestProcessor_Semaphore(t *testing.T) {
+func TestProcessor_Scheduler(t *testing.T) {
 	cache := &mockCache{}
-	p := NewProcessor(cache, 4)
+	p := NewProcessor(cache, localfs.NewPathCacheKeyer(), shared.NewDefaultFormatRegistry(), 4)
 
 	ctx := context.Background()
 
 	// Can acquire all slots
 	for i := 0; i < 4; i++ {
-		err := p.sem.Acquire(ctx, 1)
+		err := p.scheduler.Acquire(ctx)
 		assert.NoError(t, err)
 	}
 
 	// Next one should block or fail if context is canceled
 	cancelCtx, cancel := context.WithCancel(ctx)
 	cancel()
-	err := p.sem.Acquire(cancelCtx, 1)
+	err := p.scheduler.Acquire(cancelCtx)
 	assert.Error(t, err)
 
 	// Release all
 	for i := 0; i < 4; i++ {
-		p.sem.Release(1)
+		p.scheduler.Release()
 	}
 
 	// Can acquire again
-	err = p.sem.Acquire(ctx, 1)
+	err = p.scheduler.Acquire(ctx)
+	assert.NoError(t, err)
+	p.scheduler.Release()
+}
+
+func TestParseIdentifyOutput(t *testing.T) {
+	meta, err := parseIdentifyOutput("800 600 TopLeft")
 	assert.NoError(t, err)
-	p.sem.Release(1)
+	assert.Equal(t, 800, meta.Width)
+	assert.Equal(t, 600, meta.Height)
+
+	meta, err = parseIdentifyOutput("800 600 RightTop")
+	assert.NoError(t, err)
+	assert.Equal(t, 600, meta.Width, "Rotated images should report display size")
+	assert.Equal(t, 800, meta.Height)
+	assert.Equal(t, 6, meta.Orientation)
+
+	meta, err = parseIdentifyOutput("800 600 ")
+	assert.NoError(t, err)
+	assert.Equal(t, 800, meta.Width, "Orientation is optional")
+
+	_, err = parseIdentifyOutput("invalid")
+	assert.Error(t, err)
 }
@@ -2,62 +2,173 @@ package stdimage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"image"
 	_ "image/gif"
 	_ "image/jpeg"
 	_ "image/png"
+	"io"
 	"os"
-	"path/filepath"
-	"strings"
 
 	appimage "main/internal/application/image"
 	"main/internal/shared"
 
+	_ "golang.org/x/image/bmp"
+	_ "golang.org/x/image/tiff"
 	_ "golang.org/x/image/webp"
 )
 
+// HybridProcessor 按格式注册表使用纯 Go 处理可以原生解码的格式，其他格式或解码失败时交给 fallback（ImageMagick）
+//
+// 纯 Go 只能编码 JPEG/PNG，协商出 WebP/AVIF 时由 fallback 编码，fallback 失败时（如不支持该格式）仍由纯 Go 输出。
+// 纯 Go 只解码动画的第一帧，需要保留动画时交给 fallback。
+// 视频的预览图和瓦片基于 video 提取的封面生成
 type HybridProcessor struct {
+	native   *Processor
 	fallback appimage.Processor
+	video    appimage.VideoProcessor
+	formats  *shared.FormatRegistry
 }
 
-func NewHybridProcessor(fallback appimage.Processor) *HybridProcessor {
+func NewHybridProcessor(native *Processor, fallback appimage.Processor, video appimage.VideoProcessor, formats *shared.FormatRegistry) *HybridProcessor {
 	return &HybridProcessor{
+		native:   native,
 		fallback: fallback,
+		video:    video,
+		formats:  formats,
 	}
 }
 
-func (p *HybridProcessor) Process(ctx context.Context, srcPath string, width, quality int) (string, error) {
-	return p.fallback.Process(ctx, srcPath, width, quality)
+func (p *HybridProcessor) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
+	if p.formats.IsVideo(srcPath) {
+		if !nativeEncodes(format) {
+			poster, err := p.poster(srcPath)(ctx)
+			if err != nil {
+				return "", err
+			}
+			if path, err := p.fallback.Process(ctx, poster, width, quality, format); err == nil || ctx.Err() != nil {
+				return path, err
+			}
+		}
+		return p.native.process(ctx, srcPath, p.poster(srcPath), width, quality)
+	}
+	if !p.formats.IsNative(srcPath) || p.formats.KeepAnimation(srcPath, format) {
+		return p.fallback.Process(ctx, srcPath, width, quality, format)
+	}
+
+	var fallbackErr error
+	if !nativeEncodes(format) {
+		var path string
+		path, fallbackErr = p.fallback.Process(ctx, srcPath, width, quality, format)
+		if fallbackErr == nil || ctx.Err() != nil {
+			return path, fallbackErr
+		}
+	}
+
+	path, err := p.native.Process(ctx, srcPath, width, quality)
+	if err == nil || ctx.Err() != nil {
+		return path, err
+	}
+	if fallbackErr != nil {
+		return "", errors.Join(err, fallbackErr)
+	}
+
+	// 标准库不支持的变体（如动画 WebP）交给 fallback 处理
+	path, fallbackErr = p.fallback.Process(ctx, srcPath, width, quality, format)
+	if fallbackErr != nil {
+		return "", errors.Join(err, fallbackErr)
+	}
+	return path, nil
 }
 
-func (p *HybridProcessor) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
-	ext := strings.ToLower(filepath.Ext(srcPath))
+// nativeEncodes 判断纯 Go 能否按 format 输出，纯 Go 输出 JPEG 或（带透明度时）PNG
+func nativeEncodes(format shared.ImageFormat) bool {
+	return format != shared.ImageFormatAVIF && format != shared.ImageFormatWebP
+}
+
+// Tile 生成瓦片，标准库不支持的格式先由 fallback 转换为原尺寸 JPEG
+func (p *HybridProcessor) Tile(ctx context.Context, srcPath string, tileSize, level, col, row int) (string, error) {
+	meta := func(ctx context.Context) (*shared.ImageMeta, error) {
+		return p.Meta(ctx, srcPath)
+	}
+	if p.formats.IsVideo(srcPath) {
+		return p.native.tile(ctx, srcPath, p.poster(srcPath), meta, tileSize, level, col, row)
+	}
+	if p.formats.IsNative(srcPath) {
+		return p.native.tile(ctx, srcPath, func(context.Context) (string, error) {
+			return srcPath, nil
+		}, meta, tileSize, level, col, row)
+	}
+	return p.native.tile(ctx, srcPath, func(ctx context.Context) (string, error) {
+		return p.fallback.Process(ctx, srcPath, 0, 100, shared.ImageFormatJPEG)
+	}, meta, tileSize, level, col, row)
+}
 
-	switch ext {
-	case ".jpg", ".jpeg", ".png", ".gif", ".webp":
-		return p.getImageMeta(srcPath)
-	default:
+func (p *HybridProcessor) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
+	if p.formats.IsVideo(srcPath) {
+		if p.video == nil {
+			return nil, errVideoUnsupported
+		}
+		return p.video.Meta(ctx, srcPath)
+	}
+	if !p.formats.IsNative(srcPath) {
 		return p.fallback.Meta(ctx, srcPath)
 	}
+	meta, err := imageMeta(srcPath)
+	if err != nil && ctx.Err() == nil {
+		// 标准库不支持的变体（如 TIFF 的部分压缩方式）
+		if fallbackMeta, fallbackErr := p.fallback.Meta(ctx, srcPath); fallbackErr == nil {
+			return fallbackMeta, nil
+		}
+	}
+	return meta, err
+}
+
+// errVideoUnsupported 表示未配置视频处理（例如未安装 ffmpeg）
+var errVideoUnsupported = errors.New("video processing is not available")
+
+// poster 返回提取视频封面的函数
+func (p *HybridProcessor) poster(srcPath string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		if p.video == nil {
+			return "", errVideoUnsupported
+		}
+		return p.video.Poster(ctx, srcPath)
+	}
 }
 
-func (p *HybridProcessor) getImageMeta(srcPath string) (*shared.ImageMeta, error) {
+// imageMeta 使用标准库读取图片尺寸和方向
+func imageMeta(srcPath string) (*shared.ImageMeta, error) {
 	file, err := os.Open(srcPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open image: %w", err)
 	}
 	defer file.Close()
 
+	orientation, err := readFileOrientation(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read orientation: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
 	config, _, err := image.DecodeConfig(file)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode image config: %w", err)
 	}
 
-	return &shared.ImageMeta{
-		Width:  config.Width,
-		Height: config.Height,
-	}, nil
+	// 返回按方向旋转后的显示尺寸
+	meta := &shared.ImageMeta{
+		Width:       config.Width,
+		Height:      config.Height,
+		Orientation: orientation,
+	}
+	if orientationSwapsAxes(orientation) {
+		meta.Width, meta.Height = meta.Height, meta.Width
+	}
+	return meta, nil
 }
 
 var _ appimage.Processor = (*HybridProcessor)(nil)
+var _ appimage.TileProcessor = (*HybridProcessor)(nil)
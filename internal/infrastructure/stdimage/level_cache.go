@@ -0,0 +1,91 @@
+package stdimage
+
+import (
+	"fmt"
+	"image"
+	"sync"
+
+	"main/internal/forked/container/list"
+	"main/internal/shared"
+)
+
+// defaultLevelCacheBytes 是内存中保留的瓦片层级的总像素字节数
+const defaultLevelCacheBytes = 256 << 20
+
+// levelCache 在内存中保留最近使用的瓦片层级，用于只编码请求的瓦片
+//
+// 超过容量时淘汰最久未使用的层级，刚加入的层级即使超过容量也保留，
+// 因此超大图片的原始尺寸层级会在生成下一个层级后释放
+type levelCache struct {
+	capacity int64
+
+	mu      sync.Mutex
+	size    int64
+	entries map[string]*list.Element[*levelEntry]
+	lru     *list.List[*levelEntry] // 最近使用的在前
+}
+
+type levelEntry struct {
+	key    string
+	image  *image.RGBA
+	format shared.ImageFormat
+}
+
+func newLevelCache(capacity int64) *levelCache {
+	return &levelCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element[*levelEntry]),
+		lru:      list.New[*levelEntry](),
+	}
+}
+
+func levelKey(key string, level int) string {
+	return fmt.Sprintf("%s_%d", key, level)
+}
+
+// get 返回层级的图片
+func (c *levelCache) get(key string, level int) (*levelEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[levelKey(key, level)]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return el.Value, true
+}
+
+// closest 返回比 level 大的层级中最小的一个，从它缩小比从原图缩小快
+func (c *levelCache) closest(key string, level, maxLevel int) (*levelEntry, int, bool) {
+	for l := level + 1; l <= maxLevel; l++ {
+		if e, ok := c.get(key, l); ok {
+			return e, l, true
+		}
+	}
+	return nil, 0, false
+}
+
+func (c *levelCache) put(key string, level int, img *image.RGBA, format shared.ImageFormat) *levelEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &levelEntry{key: levelKey(key, level), image: img, format: format}
+	if el, ok := c.entries[e.key]; ok {
+		c.size -= levelBytes(el.Value.image)
+		c.lru.Remove(el)
+	}
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.size += levelBytes(img)
+	for c.size > c.capacity && c.lru.Len() > 1 {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.key)
+		c.size -= levelBytes(oldest.Value.image)
+	}
+	return e
+}
+
+func levelBytes(img *image.RGBA) int64 {
+	return int64(len(img.Pix))
+}
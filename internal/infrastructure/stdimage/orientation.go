@@ -0,0 +1,355 @@
+package stdimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"io"
+	"regexp"
+)
+
+// #region EXIF Orientation
+
+const exifOrientationTag = 0x0112
+
+// readOrientation 从 JPEG/PNG/WebP/TIFF 文件内容中读取方向，优先使用 EXIF，
+// 其次使用嵌入的 XMP（tiff:Orientation），找不到时返回 1
+func readOrientation(data []byte) int {
+	if orientation := readEXIFOrientation(data); orientation != 0 {
+		return orientation
+	}
+	if orientation := readXMPOrientation(data); orientation != 0 {
+		return orientation
+	}
+	return 1
+}
+
+// readEXIFOrientation 读取 EXIF 方向，找不到时返回 0
+func readEXIFOrientation(data []byte) int {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8}):
+		return readJPEGOrientation(data)
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return readPNGOrientation(data)
+	case isWebP(data):
+		return readWebPOrientation(data)
+	case isTIFF(data):
+		// TIFF 文件本身就是 EXIF 使用的结构
+		return readTIFFOrientation(data)
+	}
+	return 0
+}
+
+func isWebP(data []byte) bool {
+	return len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP"
+}
+
+func isTIFF(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("II*\x00")) || bytes.HasPrefix(data, []byte("MM\x00*"))
+}
+
+// xmpOrientationPattern 匹配属性或元素形式的 tiff:Orientation
+var xmpOrientationPattern = regexp.MustCompile(`tiff:Orientation(?:="|>)\s*([1-8])\s*(?:"|<)`)
+
+// readXMPOrientation 从嵌入的 XMP 数据包中读取方向，找不到时返回 0
+//
+// XMP 数据包以明文嵌入各格式的元数据块中，直接搜索即可
+func readXMPOrientation(data []byte) int {
+	start := bytes.Index(data, []byte("<x:xmpmeta"))
+	if start < 0 {
+		return 0
+	}
+	end := bytes.Index(data[start:], []byte("</x:xmpmeta>"))
+	if end < 0 {
+		return 0
+	}
+	match := xmpOrientationPattern.FindSubmatch(data[start : start+end])
+	if match == nil {
+		return 0
+	}
+	return int(match[1][0] - '0')
+}
+
+// orientationProbeSize 是读取方向时首先读取的文件大小，通常足以包含元数据
+const orientationProbeSize = 256 << 10
+
+// maxMetadataSize 是按结构定位读取的单个元数据块的大小上限
+const maxMetadataSize = 4 << 20
+
+// readFileOrientation 读取图片文件的方向，元数据可能位于文件末尾时按文件结构定位读取，不读取图像数据
+func readFileOrientation(r io.ReadSeeker) (int, error) {
+	head := make([]byte, orientationProbeSize)
+	n, err := io.ReadFull(r, head)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return readOrientation(head[:n]), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if orientation := readOrientation(head); orientation != 1 || !metadataMayFollow(head) {
+		return orientation, nil
+	}
+	if isWebP(head) {
+		return readWebPFileOrientation(r)
+	}
+	return readTIFFFileOrientation(r, head)
+}
+
+// readWebPFileOrientation 依次跳过 RIFF 块，只读取 EXIF 和 XMP 块
+func readWebPFileOrientation(r io.ReadSeeker) (int, error) {
+	xmpOrientation := 0
+	for offset := int64(12); ; {
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return 0, err
+		}
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return 0, err
+		}
+		fourCC := string(header[:4])
+		length := int64(binary.LittleEndian.Uint32(header[4:]))
+		if (fourCC == "EXIF" || fourCC == "XMP ") && length <= maxMetadataSize {
+			chunk, err := readAtMost(r, length)
+			if err != nil {
+				return 0, err
+			}
+			if fourCC == "EXIF" {
+				if orientation := readTIFFOrientation(bytes.TrimPrefix(chunk, []byte("Exif\x00\x00"))); orientation != 0 {
+					return orientation, nil
+				}
+			} else if orientation := readXMPOrientation(chunk); orientation != 0 {
+				xmpOrientation = orientation
+			}
+		}
+		offset += 8 + length + length%2 // 块按偶数字节对齐
+	}
+	if xmpOrientation != 0 {
+		return xmpOrientation, nil
+	}
+	return 1, nil
+}
+
+// tiffXMPTag 是 TIFF 中存放 XMP 数据包的标签（XMLPacket）
+const tiffXMPTag = 700
+
+// readTIFFFileOrientation 定位到第一个 IFD 读取方向，没有时读取 IFD 中指向的 XMP 数据包
+func readTIFFFileOrientation(r io.ReadSeeker, head []byte) (int, error) {
+	order := tiffByteOrder(head)
+	if order == nil {
+		return 1, nil
+	}
+	if _, err := r.Seek(int64(order.Uint32(head[4:])), io.SeekStart); err != nil {
+		return 0, err
+	}
+	var count [2]byte
+	if _, err := io.ReadFull(r, count[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return 1, nil
+		}
+		return 0, err
+	}
+	entries, err := readAtMost(r, int64(order.Uint16(count[:]))*12)
+	if err != nil {
+		return 0, err
+	}
+
+	var xmpOffset, xmpLength int64
+	for entry := 0; entry+12 <= len(entries); entry += 12 {
+		switch order.Uint16(entries[entry:]) {
+		case exifOrientationTag:
+			if v := int(order.Uint16(entries[entry+8:])); v >= 1 && v <= 8 {
+				return v, nil
+			}
+		case tiffXMPTag:
+			// 类型为 BYTE 或 UNDEFINED，长度大于 4 时值为偏移
+			xmpLength = int64(order.Uint32(entries[entry+4:]))
+			xmpOffset = int64(order.Uint32(entries[entry+8:]))
+		}
+	}
+	if xmpLength <= 4 || xmpLength > maxMetadataSize {
+		return 1, nil
+	}
+	if _, err := r.Seek(xmpOffset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	packet, err := readAtMost(r, xmpLength)
+	if err != nil {
+		return 0, err
+	}
+	if orientation := readXMPOrientation(packet); orientation != 0 {
+		return orientation, nil
+	}
+	return 1, nil
+}
+
+// readAtMost 读取 n 字节，文件提前结束时返回已读取的部分
+func readAtMost(r io.Reader, n int64) ([]byte, error) {
+	return io.ReadAll(io.LimitReader(r, n))
+}
+
+// metadataMayFollow 判断元数据是否可能位于图像数据之后
+//
+// WebP 的 EXIF/XMP 块通常在图像数据之后，TIFF 的 IFD 可以位于任意位置
+func metadataMayFollow(head []byte) bool {
+	if isWebP(head) {
+		// VP8X 标志位：EXIF 0x08，XMP 0x04
+		return len(head) >= 21 && string(head[12:16]) == "VP8X" && head[20]&0x0C != 0
+	}
+	return isTIFF(head)
+}
+
+func readJPEGOrientation(data []byte) int {
+	i := 2
+	for i+4 <= len(data) && data[i] == 0xFF {
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			// 图像数据开始，之后不会再有 APP1
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			break
+		}
+		segment := data[i+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return readTIFFOrientation(segment[6:])
+		}
+		i = end
+	}
+	return 0
+}
+
+func readPNGOrientation(data []byte) int {
+	i := 8
+	for i+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		chunkType := string(data[i+4 : i+8])
+		start := i + 8
+		end := start + length
+		if length < 0 || end+4 > len(data) {
+			break
+		}
+		switch chunkType {
+		case "eXIf":
+			return readTIFFOrientation(data[start:end])
+		case "IDAT":
+			// eXIf 必须在 IDAT 之前
+			return 0
+		}
+		i = end + 4 // CRC
+	}
+	return 0
+}
+
+func readWebPOrientation(data []byte) int {
+	i := 12
+	for i+8 <= len(data) {
+		fourCC := string(data[i : i+4])
+		length := int(binary.LittleEndian.Uint32(data[i+4:]))
+		start := i + 8
+		end := start + length
+		if length < 0 || end > len(data) {
+			break
+		}
+		if fourCC == "EXIF" {
+			// 部分工具会保留 JPEG 的 Exif 头
+			return readTIFFOrientation(bytes.TrimPrefix(data[start:end], []byte("Exif\x00\x00")))
+		}
+		i = end + length%2 // 块按偶数字节对齐
+	}
+	return 0
+}
+
+// readTIFFOrientation 从 TIFF 结构的第一个 IFD 中读取方向，找不到时返回 0
+func readTIFFOrientation(data []byte) int {
+	if len(data) < 8 {
+		return 0
+	}
+	order := tiffByteOrder(data)
+	if order == nil {
+		return 0
+	}
+	offset := int(order.Uint32(data[4:]))
+	if offset < 8 || offset+2 > len(data) {
+		return 0
+	}
+	count := int(order.Uint16(data[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(data) {
+			break
+		}
+		if order.Uint16(data[entry:]) == exifOrientationTag {
+			v := int(order.Uint16(data[entry+8:]))
+			if v >= 1 && v <= 8 {
+				return v
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// tiffByteOrder 返回 TIFF 结构的字节序，不是 TIFF 结构时返回 nil
+func tiffByteOrder(data []byte) binary.ByteOrder {
+	if len(data) < 8 {
+		return nil
+	}
+	switch string(data[0:2]) {
+	case "II":
+		return binary.LittleEndian
+	case "MM":
+		return binary.BigEndian
+	}
+	return nil
+}
+
+// orientationSwapsAxes 判断方向是否需要交换宽高
+func orientationSwapsAxes(orientation int) bool {
+	return orientation >= 5 && orientation <= 8
+}
+
+// applyOrientation 按 EXIF 方向变换图片，使其按正确方向显示
+func applyOrientation(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientationSwapsAxes(orientation) {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			si := src.PixOffset(b.Min.X+sx, b.Min.Y+sy)
+			di := dst.PixOffset(x, y)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+// #endregion
@@ -0,0 +1,185 @@
+package stdimage
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"os"
+	"sync"
+
+	appimage "main/internal/application/image"
+	domainimage "main/internal/domain/image"
+	"main/internal/infrastructure/thumbhash"
+	"main/internal/shared"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// placeholderSourceWidth 是生成占位图使用的预览图宽度，与 ThumbHash 的输入尺寸一致
+	placeholderSourceWidth = thumbhash.MaxSize
+	// placeholderWorkers 是后台生成占位图的并发数
+	placeholderWorkers = 2
+	// placeholderQueueSize 是等待生成的占位图数量上限，队列已满时丢弃，下次查询时重新加入
+	placeholderQueueSize = 256
+)
+
+// PlaceholderGenerator 基于小尺寸预览图生成 ThumbHash 占位图
+//
+// 预览图由 processor 生成，因此支持所有来源格式（包括视频封面），并已按方向旋转、转换为 sRGB。
+// 结果与指定的方向一起保存在图片索引中，查询时只读取索引，尚未生成时加入后台队列
+type PlaceholderGenerator struct {
+	processor appimage.Processor
+	index     domainimage.Index
+	logger    *zap.Logger
+	queue     chan placeholderJob
+
+	mu     sync.Mutex
+	queued map[placeholderJob]struct{}
+}
+
+type placeholderJob struct {
+	srcPath     string
+	orientation int
+}
+
+// NewPlaceholderGenerator 创建占位图生成器并启动后台任务，返回的函数用于停止后台任务
+func NewPlaceholderGenerator(processor appimage.Processor, index domainimage.Index, logger *zap.Logger) (*PlaceholderGenerator, func()) {
+	g := &PlaceholderGenerator{
+		processor: processor,
+		index:     index,
+		logger:    logger,
+		queue:     make(chan placeholderJob, placeholderQueueSize),
+		queued:    make(map[placeholderJob]struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(appimage.ContextWithPriority(context.Background(), appimage.PriorityBackground))
+	var wg sync.WaitGroup
+	for range placeholderWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g.run(ctx)
+		}()
+	}
+
+	return g, func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// Placeholder 返回 Base64 编码的 ThumbHash，context 中指定的方向优先于原图中的方向
+//
+// 尚未生成或文件已变化时加入后台队列并返回 false
+func (g *PlaceholderGenerator) Placeholder(ctx context.Context, srcPath string) (string, bool) {
+	info, err := os.Stat(srcPath)
+	if err != nil {
+		return "", false
+	}
+
+	job := placeholderJob{srcPath: srcPath, orientation: appimage.OrientationFromContext(ctx)}
+	if hash, ok := g.index.Get(srcPath).PlaceholderFor(info.Size(), info.ModTime(), job.orientation); ok {
+		return hash, true
+	}
+	g.enqueue(job)
+	return "", false
+}
+
+func (g *PlaceholderGenerator) enqueue(job placeholderJob) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.queued[job]; ok {
+		return
+	}
+	select {
+	case g.queue <- job:
+		g.queued[job] = struct{}{}
+	default:
+	}
+}
+
+func (g *PlaceholderGenerator) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-g.queue:
+			g.process(ctx, job)
+			g.mu.Lock()
+			delete(g.queued, job)
+			g.mu.Unlock()
+		}
+	}
+}
+
+// process 生成占位图并写入索引，生成期间文件变化时不写入
+func (g *PlaceholderGenerator) process(ctx context.Context, job placeholderJob) {
+	info, err := os.Stat(job.srcPath)
+	if err != nil {
+		return
+	}
+	if _, ok := g.index.Get(job.srcPath).PlaceholderFor(info.Size(), info.ModTime(), job.orientation); ok {
+		return
+	}
+
+	if job.orientation != 0 {
+		ctx = appimage.ContextWithOrientation(ctx, job.orientation)
+	}
+	hash, err := g.generate(ctx, job.srcPath)
+	if err != nil {
+		if ctx.Err() == nil {
+			g.logger.Warn("failed to generate image placeholder",
+				zap.String("path", job.srcPath),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+
+	current, err := os.Stat(job.srcPath)
+	if err != nil || current.Size() != info.Size() || !current.ModTime().Equal(info.ModTime()) {
+		return
+	}
+	// 基于最新的记录更新，保留扫描写入的尺寸和 sidecar 等信息
+	entry := domainimage.IndexEntry{Size: info.Size(), ModTime: info.ModTime()}
+	if cached := g.index.Get(job.srcPath); cached != nil && cached.Size == info.Size() && cached.ModTime.Equal(info.ModTime()) {
+		entry = *cached
+	}
+	entry.Placeholder = hash
+	entry.PlaceholderOrientation = job.orientation
+	g.index.Put(job.srcPath, &entry)
+}
+
+func (g *PlaceholderGenerator) generate(ctx context.Context, srcPath string) (string, error) {
+	// 输出 JPEG 或（带透明度时）PNG，均可由标准库解码
+	path, err := g.processor.Process(ctx, srcPath, placeholderSourceWidth, 0, shared.ImageFormatJPEG)
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return "", fmt.Errorf("decode preview: %w", err)
+	}
+
+	// 竖图按宽度缩放后高度仍可能超出限制
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if scale := float64(thumbhash.MaxSize) / float64(max(w, h)); scale < 1 {
+		img = resizeTo(img, max(1, int(float64(w)*scale)), max(1, int(float64(h)*scale)))
+	}
+
+	hash, err := thumbhash.Encode(img)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(hash), nil
+}
+
+var _ appimage.PlaceholderGenerator = (*PlaceholderGenerator)(nil)
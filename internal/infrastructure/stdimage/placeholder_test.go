@@ -0,0 +1,110 @@
+package stdimage
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appimage "main/internal/application/image"
+	domainimage "main/internal/domain/image"
+	"main/internal/infrastructure/localfs"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type countingProcessor struct {
+	appimage.Processor
+	calls atomic.Int32
+}
+
+func (p *countingProcessor) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
+	p.calls.Add(1)
+	return p.Processor.Process(ctx, srcPath, width, quality, format)
+}
+
+// memIndex 是内存中的图片索引
+type memIndex struct {
+	mu      sync.Mutex
+	entries map[string]*domainimage.IndexEntry
+}
+
+func (i *memIndex) Get(path string) *domainimage.IndexEntry {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.entries[path]
+}
+
+func (i *memIndex) Put(path string, entry *domainimage.IndexEntry) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.entries[path] = entry
+}
+
+func (i *memIndex) Delete(path string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.entries, path)
+}
+
+// waitPlaceholder 等待后台生成占位图
+func waitPlaceholder(t *testing.T, g *PlaceholderGenerator, ctx context.Context, src string) string {
+	var placeholder string
+	require.Eventually(t, func() bool {
+		var ok bool
+		placeholder, ok = g.Placeholder(ctx, src)
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	return placeholder
+}
+
+func TestPlaceholderGenerator(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.jpg")
+	writeTestJPEG(t, src, 60, 300, 0)
+
+	processor := &countingProcessor{
+		Processor: NewHybridProcessor(NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1), &mockFallback{}, nil, shared.NewDefaultFormatRegistry()),
+	}
+	index := &memIndex{entries: make(map[string]*domainimage.IndexEntry)}
+	g, cleanup := NewPlaceholderGenerator(processor, index, zap.NewNop())
+	defer cleanup()
+	ctx := context.Background()
+
+	// 尚未生成时在后台生成
+	_, ok := g.Placeholder(ctx, src)
+	assert.False(t, ok)
+	placeholder := waitPlaceholder(t, g, ctx, src)
+	hash, err := base64.StdEncoding.DecodeString(placeholder)
+	require.NoError(t, err)
+	assert.Zero(t, hash[4]&0x80, "portrait image should not set landscape flag")
+	assert.EqualValues(t, 1, processor.calls.Load())
+	assert.Equal(t, placeholder, index.Get(src).Placeholder, "placeholder should be stored in index")
+
+	// 文件未变化时使用索引
+	cached, ok := g.Placeholder(ctx, src)
+	require.True(t, ok)
+	assert.Equal(t, placeholder, cached)
+	assert.EqualValues(t, 1, processor.calls.Load())
+
+	// 指定的方向不同时重新生成，旋转 90 度后为横图
+	rotated := waitPlaceholder(t, g, appimage.ContextWithOrientation(ctx, 6), src)
+	hash, err = base64.StdEncoding.DecodeString(rotated)
+	require.NoError(t, err)
+	assert.NotZero(t, hash[4]&0x80, "rotated image should set landscape flag")
+	assert.EqualValues(t, 2, processor.calls.Load())
+
+	// 修改时间变化后重新生成
+	require.NoError(t, os.Chtimes(src, time.Now(), time.Now().Add(time.Hour)))
+	_, ok = g.Placeholder(ctx, src)
+	assert.False(t, ok)
+	waitPlaceholder(t, g, ctx, src)
+	assert.EqualValues(t, 3, processor.calls.Load())
+}
@@ -0,0 +1,220 @@
+package stdimage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"os"
+
+	appimage "main/internal/application/image"
+	"main/internal/infrastructure/concurrency"
+	"main/internal/infrastructure/icc"
+	"main/internal/shared"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/sync/singleflight"
+)
+
+// Processor 使用纯 Go 实现缩放和编码，不依赖 ImageMagick
+//
+// 标准库只能编码 JPEG 和 PNG：不透明图片输出 JPEG，带透明度的图片输出 PNG。
+// 输出按方向旋转并转换为 sRGB，不保留元数据
+type Processor struct {
+	cache     appimage.Cache
+	keyer     appimage.CacheKeyer
+	scheduler *concurrency.PriorityScheduler
+	tiles     singleflight.Group
+	levels    *levelCache
+}
+
+func NewProcessor(cache appimage.Cache, keyer appimage.CacheKeyer, limit int64) *Processor {
+	if limit <= 0 {
+		limit = 4
+	}
+	return &Processor{
+		cache: cache,
+		keyer: keyer,
+		// 解码后的原图占用大量内存，需要限制并发，空闲名额优先分配给正在查看的图片
+		scheduler: concurrency.NewPriorityScheduler(limit, concurrency.DefaultPriorityAging),
+		levels:    newLevelCache(defaultLevelCacheBytes),
+	}
+}
+
+func (p *Processor) Process(ctx context.Context, srcPath string, width, quality int) (string, error) {
+	return p.process(ctx, srcPath, func(context.Context) (string, error) {
+		return srcPath, nil
+	}, width, quality)
+}
+
+// process 缩放图片，resolveDecodePath 返回实际解码的文件，只在需要生成时调用
+//
+// 用于先由 ffmpeg 提取视频封面，缓存键仍然基于 srcPath
+func (p *Processor) process(
+	ctx context.Context,
+	srcPath string,
+	resolveDecodePath func(ctx context.Context) (string, error),
+	width, quality int,
+) (string, error) {
+	// 与 ImageMagick 的输出不同，使用独立的缓存键
+	cacheKey, err := p.keyer.Key(srcPath, fmt.Sprintf("stdimage|%d|%d|srgb", width, quality)+orientationParams(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	if path, ok := p.lookup(cacheKey); ok {
+		return path, nil
+	}
+
+	decodePath, err := resolveDecodePath(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := p.scheduler.Acquire(ctx); err != nil {
+		return "", err
+	}
+	defer p.scheduler.Release()
+
+	src, err := decode(ctx, decodePath)
+	if err != nil {
+		return "", err
+	}
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+
+	resized := resize(src.image, width, orientationSwapsAxes(src.orientation))
+	src.toSRGB(resized)
+	dst := applyOrientation(resized, src.orientation)
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+
+	format := outputFormat(dst)
+	return p.cache.Save(cacheKey+format.Meta().Extension, func(f *os.File) error {
+		return encode(f, dst, format, quality)
+	})
+}
+
+// lookup 查找缓存，输出格式取决于解码后是否透明，需要检查所有可能的扩展名
+func (p *Processor) lookup(cacheKey string) (string, bool) {
+	return p.cache.Lookup(
+		cacheKey+shared.ImageFormatJPEG.Meta().Extension,
+		cacheKey+shared.ImageFormatPNG.Meta().Extension,
+	)
+}
+
+// source 是解码后的原图及正确显示所需的信息
+type source struct {
+	image       image.Image
+	orientation int
+	transform   *icc.Transform // 嵌入的配置文件到 sRGB 的转换，无需转换时为 nil
+}
+
+// decode 解码图片并读取方向和嵌入的 ICC 配置文件，context 中指定的方向优先
+func decode(ctx context.Context, srcPath string) (*source, error) {
+	data, err := os.ReadFile(srcPath)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	orientation := appimage.OrientationFromContext(ctx)
+	if orientation == 0 {
+		orientation = readOrientation(data)
+	}
+	return &source{
+		image:       img,
+		orientation: orientation,
+		transform:   colorTransform(data),
+	}, nil
+}
+
+// orientationParams 返回 context 中指定方向时追加到缓存键的参数
+func orientationParams(ctx context.Context) string {
+	if orientation := appimage.OrientationFromContext(ctx); orientation != 0 {
+		return fmt.Sprintf("|orientation|%d", orientation)
+	}
+	return ""
+}
+
+// colorTransform 返回嵌入的配置文件到 sRGB 的转换，没有配置文件、已是 sRGB 或不支持时返回 nil
+//
+// 浏览器将没有配置文件的图片视为 sRGB，广色域图片需要转换，否则显示时颜色偏淡
+func colorTransform(data []byte) *icc.Transform {
+	raw := icc.Extract(data)
+	if raw == nil {
+		return nil
+	}
+	profile, err := icc.Parse(raw)
+	if err != nil || profile.IsSRGB() {
+		return nil
+	}
+	return icc.NewTransform(profile)
+}
+
+// toSRGB 将缩放后的图片转换为 sRGB，缩放后处理以减少计算量
+func (s *source) toSRGB(img *image.RGBA) {
+	if s.transform != nil {
+		s.transform.Apply(img)
+	}
+}
+
+// outputFormat 不透明图片输出 JPEG，带透明度的图片输出 PNG
+func outputFormat(img *image.RGBA) shared.ImageFormat {
+	if img.Opaque() {
+		return shared.ImageFormatJPEG
+	}
+	return shared.ImageFormatPNG
+}
+
+func encode(w io.Writer, img image.Image, format shared.ImageFormat, quality int) error {
+	if format == shared.ImageFormatPNG {
+		return png.Encode(w, img)
+	}
+	options := &jpeg.Options{Quality: jpeg.DefaultQuality}
+	if quality > 0 {
+		options.Quality = min(quality, 100)
+	}
+	return jpeg.Encode(w, img, options)
+}
+
+// resize 按显示宽度等比缩小图片，不放大
+//
+// swapAxes 为 true 时原图需要旋转 90 度显示，宽度限制作用于原图高度
+func resize(src image.Image, width int, swapAxes bool) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	displayWidth := w
+	if swapAxes {
+		displayWidth = h
+	}
+
+	dw, dh := w, h
+	if width > 0 && displayWidth > width {
+		scale := float64(width) / float64(displayWidth)
+		dw = max(1, int(math.Round(float64(w)*scale)))
+		dh = max(1, int(math.Round(float64(h)*scale)))
+	}
+	return resizeTo(src, dw, dh)
+}
+
+// resizeTo 将图片缩放到指定尺寸
+func resizeTo(src image.Image, dw, dh int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	if dw == w && dh == h {
+		draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Src)
+	} else {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Src, nil)
+	}
+	return dst
+}
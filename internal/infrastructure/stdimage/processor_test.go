@@ -0,0 +1,496 @@
+package stdimage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	appimage "main/internal/application/image"
+	"main/internal/infrastructure/icc"
+	"main/internal/infrastructure/localfs"
+	"main/internal/shared"
+	"main/internal/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/tiff"
+)
+
+type mockCache struct {
+	dir string
+}
+
+func (m *mockCache) GetPath(key string) string {
+	return filepath.Join(m.dir, key)
+}
+
+func (m *mockCache) Lookup(keys ...string) (string, bool) {
+	for _, key := range keys {
+		if _, err := os.Stat(m.GetPath(key)); err == nil {
+			return m.GetPath(key), true
+		}
+	}
+	return "", false
+}
+
+func (m *mockCache) Save(key string, write func(file *os.File) error) (string, error) {
+	path := m.GetPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	return path, util.AtomicSave(path, write)
+}
+
+// mockFallback 记录调用，设置了 dir 时输出只有文件头的对应格式文件，否则返回错误
+type mockFallback struct {
+	calls   int
+	formats []shared.ImageFormat
+	srcs    []string
+	dir     string
+}
+
+// formatSignatures 是各输出格式的文件头
+var formatSignatures = map[shared.ImageFormat][]byte{
+	shared.ImageFormatAVIF: []byte("\x00\x00\x00\x1cftypavif"),
+	shared.ImageFormatWebP: []byte("RIFF\x00\x00\x00\x00WEBP"),
+}
+
+func (m *mockFallback) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
+	m.calls++
+	m.formats = append(m.formats, format)
+	m.srcs = append(m.srcs, srcPath)
+	if m.dir == "" {
+		return "", errors.New("fallback")
+	}
+	path := filepath.Join(m.dir, fmt.Sprintf("%d%s", m.calls, format.Meta().Extension))
+	return path, os.WriteFile(path, formatSignatures[format], 0644)
+}
+
+func (m *mockFallback) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
+	return nil, errors.New("fallback")
+}
+
+// mockVideo 返回预先写入的封面
+type mockVideo struct {
+	poster  string
+	posters int
+}
+
+func (m *mockVideo) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
+	return &shared.ImageMeta{Width: 10, Height: 6, Duration: 3 * time.Second}, nil
+}
+
+func (m *mockVideo) Poster(ctx context.Context, srcPath string) (string, error) {
+	m.posters++
+	return m.poster, nil
+}
+
+// newExifSegment 创建只包含方向的 JPEG APP1 段
+func newExifSegment(orientation uint16) []byte {
+	tiff := new(bytes.Buffer)
+	tiff.WriteString("MM")
+	binary.Write(tiff, binary.BigEndian, uint16(42))
+	binary.Write(tiff, binary.BigEndian, uint32(8))
+	binary.Write(tiff, binary.BigEndian, uint16(1))
+	binary.Write(tiff, binary.BigEndian, uint16(exifOrientationTag))
+	binary.Write(tiff, binary.BigEndian, uint16(3)) // SHORT
+	binary.Write(tiff, binary.BigEndian, uint32(1))
+	binary.Write(tiff, binary.BigEndian, orientation)
+	binary.Write(tiff, binary.BigEndian, uint16(0))
+	binary.Write(tiff, binary.BigEndian, uint32(0))
+
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// writeTestJPEG 写入左半红色、右半蓝色的 JPEG
+func writeTestJPEG(t *testing.T, path string, w, h int, orientation uint16) {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{255, 0, 0, 255}
+			if x >= w/2 {
+				c = color.RGBA{0, 0, 255, 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, jpeg.Encode(buf, img, &jpeg.Options{Quality: 95}))
+	data := buf.Bytes()
+	if orientation != 0 {
+		data = append(append([]byte{0xFF, 0xD8}, newExifSegment(orientation)...), data[2:]...)
+	}
+	require.NoError(t, os.WriteFile(path, data, 0644))
+}
+
+func decodeFile(t *testing.T, path string) (image.Image, string) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	img, format, err := image.Decode(f)
+	require.NoError(t, err)
+	return img, format
+}
+
+func TestReadOrientation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.jpg")
+	writeTestJPEG(t, path, 8, 4, 6)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 6, readOrientation(data))
+
+	assert.Equal(t, 1, readOrientation([]byte("not an image")))
+
+	// 没有 EXIF 时使用嵌入的 XMP
+	plainPath := filepath.Join(t.TempDir(), "b.jpg")
+	writeTestJPEG(t, plainPath, 8, 4, 0)
+	plain, err := os.ReadFile(plainPath)
+	require.NoError(t, err)
+	xmp := []byte(`<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF><rdf:Description tiff:Orientation="8"/></rdf:RDF></x:xmpmeta>`)
+	assert.Equal(t, 8, readOrientation(append(newAPP1(t, xmp), plain[2:]...)))
+	xmp = []byte(`<x:xmpmeta><tiff:Orientation>3</tiff:Orientation></x:xmpmeta>`)
+	assert.Equal(t, 6, readOrientation(append(append(data[:2:2], newAPP1(t, xmp)[2:]...), data[2:]...)), "EXIF should take precedence")
+}
+
+// countingReadSeeker 记录读取的字节数
+type countingReadSeeker struct {
+	io.ReadSeeker
+	read int
+}
+
+func (r *countingReadSeeker) Read(p []byte) (int, error) {
+	n, err := r.ReadSeeker.Read(p)
+	r.read += n
+	return n, err
+}
+
+// newTIFFIFD 创建只有一个 IFD 的小端 TIFF 结构，IFD 位于 padding 之后
+func newTIFFIFD(padding int, tag, typ uint16, count, value uint32) []byte {
+	data := binary.LittleEndian.AppendUint32([]byte("II*\x00"), uint32(8+padding))
+	data = append(data, make([]byte, padding)...)
+	data = binary.LittleEndian.AppendUint16(data, 1)
+	data = binary.LittleEndian.AppendUint16(data, tag)
+	data = binary.LittleEndian.AppendUint16(data, typ)
+	data = binary.LittleEndian.AppendUint32(data, count)
+	data = binary.LittleEndian.AppendUint32(data, value)
+	return binary.LittleEndian.AppendUint32(data, 0)
+}
+
+// newWebP 创建包含指定块的 WebP 文件结构
+func newWebP(chunks ...[]byte) []byte {
+	body := []byte("WEBP")
+	for _, chunk := range chunks {
+		body = append(body, chunk...)
+	}
+	return append(binary.LittleEndian.AppendUint32([]byte("RIFF"), uint32(len(body))), body...)
+}
+
+func newRIFFChunk(fourCC string, payload []byte) []byte {
+	chunk := append(binary.LittleEndian.AppendUint32([]byte(fourCC), uint32(len(payload))), payload...)
+	if len(payload)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+func TestReadFileOrientation_TrailingMetadata(t *testing.T) {
+	const imageData = 4 * orientationProbeSize
+	xmp := []byte(`<x:xmpmeta><tiff:Orientation>5</tiff:Orientation></x:xmpmeta>`)
+	vp8x := newRIFFChunk("VP8X", []byte{0x0C, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+
+	tiffWithXMP := newTIFFIFD(imageData, tiffXMPTag, 7, uint32(len(xmp)), uint32(8+imageData+18))
+	tiffWithXMP = append(tiffWithXMP, xmp...)
+
+	testCases := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"TIFF IFD", newTIFFIFD(imageData, exifOrientationTag, 3, 1, 6), 6},
+		{"TIFF XMP", tiffWithXMP, 5},
+		{"TIFF without orientation", newTIFFIFD(imageData, 0x0100, 3, 1, 1), 1},
+		{"WebP EXIF", newWebP(vp8x, newRIFFChunk("VP8L", make([]byte, imageData+1)), newRIFFChunk("EXIF", newTIFFIFD(0, exifOrientationTag, 3, 1, 8))), 8},
+		{"WebP XMP", newWebP(vp8x, newRIFFChunk("VP8L", make([]byte, imageData)), newRIFFChunk("XMP ", xmp)), 5},
+		{"WebP truncated", newWebP(vp8x, newRIFFChunk("VP8L", make([]byte, imageData)))[:imageData], 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &countingReadSeeker{ReadSeeker: bytes.NewReader(tc.data)}
+			orientation, err := readFileOrientation(r)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, orientation)
+			assert.Less(t, r.read, orientationProbeSize+1024, "Image data should be skipped")
+		})
+	}
+}
+
+// newAPP1 创建以 SOI 开头、包含 XMP 的 JPEG APP1 段
+func newAPP1(t *testing.T, xmp []byte) []byte {
+	payload := append([]byte("http://ns.adobe.com/xap/1.0/\x00"), xmp...)
+	segment := []byte{0xFF, 0xD8, 0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+func TestHybridProcessor_Meta_Orientation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.jpg")
+	writeTestJPEG(t, path, 8, 4, 6)
+
+	p := NewHybridProcessor(NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1), &mockFallback{}, nil, shared.NewDefaultFormatRegistry())
+	meta, err := p.Meta(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, 4, meta.Width, "Meta should report display size")
+	assert.Equal(t, 8, meta.Height)
+}
+
+func TestColorTransform(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.jpg")
+	writeTestJPEG(t, path, 8, 4, 0)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Nil(t, colorTransform(data), "Images without profile are treated as sRGB")
+
+	profile := icc.SRGB()
+	segment := append([]byte("ICC_PROFILE\x00\x01\x01"), profile...)
+	app2 := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE2}, uint16(len(segment)+2))
+	withProfile := append(append([]byte{0xFF, 0xD8}, append(app2, segment...)...), data[2:]...)
+	assert.Nil(t, colorTransform(withProfile), "sRGB profile does not need conversion")
+}
+
+func TestProcessor_Process_Resize(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.jpg")
+	writeTestJPEG(t, src, 400, 200, 0)
+
+	p := NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	out, err := p.Process(context.Background(), src, 100, 80)
+	require.NoError(t, err)
+
+	img, format := decodeFile(t, out)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), img.Bounds())
+
+	// 不放大
+	out, err = p.Process(context.Background(), src, 1000, 0)
+	require.NoError(t, err)
+	img, _ = decodeFile(t, out)
+	assert.Equal(t, image.Rect(0, 0, 400, 200), img.Bounds())
+}
+
+func TestProcessor_Process_Orientation(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.jpg")
+	// 顺时针旋转 90 度显示，宽度限制作用于显示宽度（原图高度）
+	writeTestJPEG(t, src, 400, 200, 6)
+
+	p := NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	out, err := p.Process(context.Background(), src, 100, 0)
+	require.NoError(t, err)
+
+	img, _ := decodeFile(t, out)
+	require.Equal(t, image.Rect(0, 0, 100, 200), img.Bounds())
+
+	// 原图左侧（红色）旋转后位于上方
+	r, _, b, _ := img.At(50, 10).RGBA()
+	assert.Greater(t, r, b)
+	r, _, b, _ = img.At(50, 190).RGBA()
+	assert.Greater(t, b, r)
+
+	// sidecar 中设置的方向覆盖原图中的方向，并使用不同的缓存
+	ctx := appimage.ContextWithOrientation(context.Background(), 1)
+	out, err = p.Process(ctx, src, 100, 0)
+	require.NoError(t, err)
+	img, _ = decodeFile(t, out)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), img.Bounds())
+}
+
+func TestProcessor_Process_Transparent(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.png")
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	f, err := os.Create(src)
+	require.NoError(t, err)
+	require.NoError(t, png.Encode(f, img))
+	require.NoError(t, f.Close())
+
+	p := NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	out, err := p.Process(context.Background(), src, 0, 0)
+	require.NoError(t, err)
+
+	_, format := decodeFile(t, out)
+	assert.Equal(t, "png", format, "Transparent images should keep alpha channel")
+}
+
+func TestHybridProcessor_Process(t *testing.T) {
+	dir := t.TempDir()
+	jpgPath := filepath.Join(dir, "a.jpg")
+	writeTestJPEG(t, jpgPath, 10, 10, 0)
+	brokenPath := filepath.Join(dir, "b.webp")
+	require.NoError(t, os.WriteFile(brokenPath, []byte("not a webp"), 0644))
+
+	fallback := &mockFallback{}
+	p := NewHybridProcessor(NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1), fallback, nil, shared.NewDefaultFormatRegistry())
+
+	out, err := p.Process(context.Background(), jpgPath, 5, 0, shared.ImageFormatJPEG)
+	require.NoError(t, err)
+	assert.Equal(t, ".jpg", filepath.Ext(out))
+	assert.Equal(t, 0, fallback.calls, "Supported formats should not use fallback")
+
+	_, err = p.Process(context.Background(), filepath.Join(dir, "c.avif"), 5, 0, shared.ImageFormatJPEG)
+	assert.Error(t, err)
+	assert.Equal(t, 1, fallback.calls, "Unsupported formats should use fallback")
+
+	_, err = p.Process(context.Background(), brokenPath, 5, 0, shared.ImageFormatJPEG)
+	assert.Error(t, err)
+	assert.Equal(t, 2, fallback.calls, "Decode failure should use fallback")
+
+	// 浏览器 Accept 协商出 AVIF/WebP 时由 fallback 编码，fallback 失败时仍由纯 Go 输出
+	for i, format := range []shared.ImageFormat{shared.ImageFormatAVIF, shared.ImageFormatWebP} {
+		out, err = p.Process(context.Background(), jpgPath, 5, 0, format)
+		require.NoError(t, err)
+		assert.Equal(t, ".jpg", filepath.Ext(out))
+		assert.Equal(t, 3+i, fallback.calls, format.String())
+		assert.Equal(t, format, fallback.formats[2+i])
+	}
+
+	// 不支持的格式按请求的格式交给 fallback
+	_, err = p.Process(context.Background(), filepath.Join(dir, "c.avif"), 5, 0, shared.ImageFormatWebP)
+	assert.Error(t, err)
+	assert.Equal(t, shared.ImageFormatWebP, fallback.formats[4])
+}
+
+func TestHybridProcessor_Process_NegotiatedFormat(t *testing.T) {
+	dir := t.TempDir()
+	pngPath := filepath.Join(dir, "a.png")
+	f, err := os.Create(pngPath)
+	require.NoError(t, err)
+	require.NoError(t, png.Encode(f, image.NewRGBA(image.Rect(0, 0, 10, 6))))
+	require.NoError(t, f.Close())
+
+	testCases := []struct {
+		format    shared.ImageFormat
+		wantExt   string
+		wantCalls int
+	}{
+		{shared.ImageFormatAVIF, ".avif", 1},
+		{shared.ImageFormatWebP, ".webp", 1},
+		{shared.ImageFormatJPEG, ".png", 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.format.String(), func(t *testing.T) {
+			fallback := &mockFallback{dir: t.TempDir()}
+			p := NewHybridProcessor(NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1), fallback, nil, shared.NewDefaultFormatRegistry())
+
+			out, err := p.Process(context.Background(), pngPath, 5, 0, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantExt, filepath.Ext(out))
+			assert.Equal(t, tc.wantCalls, fallback.calls)
+		})
+	}
+}
+
+func TestHybridProcessor_FormatRegistry(t *testing.T) {
+	dir := t.TempDir()
+	img := image.NewRGBA(image.Rect(0, 0, 10, 6))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+
+	tiffPath := filepath.Join(dir, "a.tiff")
+	f, err := os.Create(tiffPath)
+	require.NoError(t, err)
+	require.NoError(t, tiff.Encode(f, img, nil))
+	require.NoError(t, f.Close())
+
+	gifPath := filepath.Join(dir, "b.gif")
+	f, err = os.Create(gifPath)
+	require.NoError(t, err)
+	require.NoError(t, gif.Encode(f, img, nil))
+	require.NoError(t, f.Close())
+
+	fallback := &mockFallback{}
+	native := NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+
+	p := NewHybridProcessor(native, fallback, nil, shared.NewDefaultFormatRegistry())
+	meta, err := p.Meta(context.Background(), tiffPath)
+	require.NoError(t, err)
+	assert.Equal(t, 10, meta.Width)
+	assert.Equal(t, 6, meta.Height)
+	out, err := p.Process(context.Background(), tiffPath, 5, 0, shared.ImageFormatJPEG)
+	require.NoError(t, err)
+	assert.Equal(t, ".jpg", filepath.Ext(out))
+	out, err = p.Process(context.Background(), gifPath, 5, 0, shared.ImageFormatJPEG)
+	require.NoError(t, err)
+	assert.Equal(t, ".jpg", filepath.Ext(out), "GIF first frame should be processed natively")
+	assert.Equal(t, 0, fallback.calls)
+
+	// 保留动画时交给 fallback
+	animated := shared.NewFormatRegistry(shared.DefaultSourceFormats(), shared.AnimatedPreviewAnimated)
+	p = NewHybridProcessor(native, fallback, nil, animated)
+	_, err = p.Process(context.Background(), gifPath, 5, 0, shared.ImageFormatWebP)
+	assert.Error(t, err)
+	assert.Equal(t, 1, fallback.calls)
+	_, err = p.Process(context.Background(), gifPath, 5, 0, shared.ImageFormatJPEG)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fallback.calls, "JPEG output can not keep animation")
+
+	// 未注册的格式交给 fallback
+	p = NewHybridProcessor(native, fallback, nil, shared.NewFormatRegistry([]shared.SourceFormat{shared.SourceFormatJPEG}, shared.AnimatedPreview{}))
+	_, err = p.Process(context.Background(), tiffPath, 5, 0, shared.ImageFormatJPEG)
+	assert.Error(t, err)
+	assert.Equal(t, 2, fallback.calls)
+}
+
+func TestHybridProcessor_Video(t *testing.T) {
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "a.mp4")
+	require.NoError(t, os.WriteFile(videoPath, []byte("not decoded"), 0644))
+	posterPath := filepath.Join(dir, "poster.jpg")
+	writeTestJPEG(t, posterPath, 10, 6, 0)
+
+	fallback := &mockFallback{}
+	video := &mockVideo{poster: posterPath}
+	p := NewHybridProcessor(NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1), fallback, video, shared.NewDefaultFormatRegistry())
+
+	meta, err := p.Meta(context.Background(), videoPath)
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Second, meta.Duration)
+
+	out, err := p.Process(context.Background(), videoPath, 5, 0, shared.ImageFormatJPEG)
+	require.NoError(t, err)
+	img, format := decodeFile(t, out)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, 5, img.Bounds().Dx(), "Preview should be generated from poster")
+	assert.Equal(t, 0, fallback.calls)
+
+	_, err = p.Process(context.Background(), videoPath, 5, 0, shared.ImageFormatJPEG)
+	require.NoError(t, err)
+	assert.Equal(t, 1, video.posters, "Cached preview should not extract poster again")
+
+	// 协商出的格式由 fallback 基于封面编码
+	fallback.dir = t.TempDir()
+	out, err = p.Process(context.Background(), videoPath, 5, 0, shared.ImageFormatWebP)
+	require.NoError(t, err)
+	assert.Equal(t, ".webp", filepath.Ext(out))
+	assert.Equal(t, []string{posterPath}, fallback.srcs)
+	fallback.dir = ""
+
+	p = NewHybridProcessor(NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1), fallback, nil, shared.NewDefaultFormatRegistry())
+	_, err = p.Process(context.Background(), videoPath, 5, 0, shared.ImageFormatJPEG)
+	assert.ErrorIs(t, err, errVideoUnsupported)
+}
@@ -0,0 +1,181 @@
+package stdimage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"os"
+
+	appimage "main/internal/application/image"
+	"main/internal/shared"
+)
+
+// tileQuality 是瓦片的 JPEG 质量，瓦片用于查看细节，使用较高质量
+const tileQuality = 90
+
+// Tile 返回 DZI 瓦片路径，只生成请求的瓦片
+//
+// 解码后的层级保留在内存中，同一图片的其他瓦片不需要再次解码
+func (p *Processor) Tile(ctx context.Context, srcPath string, tileSize, level, col, row int) (string, error) {
+	return p.tile(ctx, srcPath, func(context.Context) (string, error) {
+		return srcPath, nil
+	}, func(context.Context) (*shared.ImageMeta, error) {
+		return imageMeta(srcPath)
+	}, tileSize, level, col, row)
+}
+
+// tile 生成瓦片，resolveDecodePath 返回实际解码的文件，只在需要生成时调用
+//
+// 用于先由 ImageMagick 转换标准库不支持的格式，缓存键仍然基于 srcPath。
+// 层级和行列不在签名中，解码前先根据 meta 返回的尺寸检查是否超出范围
+func (p *Processor) tile(
+	ctx context.Context,
+	srcPath string,
+	resolveDecodePath func(ctx context.Context) (string, error),
+	meta func(ctx context.Context) (*shared.ImageMeta, error),
+	tileSize, level, col, row int,
+) (string, error) {
+	if tileSize <= 0 || level < 0 || col < 0 || row < 0 {
+		return "", appimage.ErrTileNotFound
+	}
+
+	key, err := p.keyer.Key(srcPath, fmt.Sprintf("tile|%d|%d|srgb", tileSize, appimage.TileOverlap)+orientationParams(ctx))
+	if err != nil {
+		return "", err
+	}
+	tileKey := fmt.Sprintf("%s_%d_%d", levelKey(key, level), col, row)
+
+	if path, ok := p.lookup(tileKey); ok {
+		return path, nil
+	}
+
+	m, err := meta(ctx)
+	if err != nil {
+		return "", err
+	}
+	width, height := m.Width, m.Height
+	if o := appimage.OrientationFromContext(ctx); o != 0 && orientationSwapsAxes(o) != orientationSwapsAxes(m.Orientation) {
+		// 指定的方向与文件中的方向旋转方向不同
+		width, height = height, width
+	}
+	if !appimage.TileInRange(width, height, tileSize, level, col, row) {
+		return "", appimage.ErrTileNotFound
+	}
+
+	levelImg, err := p.level(ctx, key, level, appimage.TileMaxLevel(width, height), resolveDecodePath)
+	if err != nil {
+		return "", err
+	}
+	return p.saveTile(levelImg, tileKey, tileSize, col, row)
+}
+
+// level 返回层级的图片，同一层级并发请求时只生成一次
+//
+// 生成使用发起请求的 context，其他调用方取消导致生成失败时重新生成
+func (p *Processor) level(
+	ctx context.Context,
+	key string,
+	level, maxLevel int,
+	resolveDecodePath func(ctx context.Context) (string, error),
+) (*levelEntry, error) {
+	for {
+		if e, ok := p.levels.get(key, level); ok {
+			return e, nil
+		}
+		ch := p.tiles.DoChan(levelKey(key, level), func() (any, error) {
+			return p.generateLevel(ctx, key, level, maxLevel, resolveDecodePath)
+		})
+		select {
+		case r := <-ch:
+			if r.Err != nil && ctx.Err() == nil && (errors.Is(r.Err, context.Canceled) || errors.Is(r.Err, context.DeadlineExceeded)) {
+				continue
+			}
+			if r.Err != nil {
+				return nil, r.Err
+			}
+			return r.Val.(*levelEntry), nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// generateLevel 从内存中最接近的较大层级逐级缩小生成层级，没有时解码原图
+//
+// 原始尺寸的层级和生成的层级都保留在内存中，后续请求只需编码请求的瓦片
+func (p *Processor) generateLevel(
+	ctx context.Context,
+	key string,
+	level, maxLevel int,
+	resolveDecodePath func(ctx context.Context) (string, error),
+) (*levelEntry, error) {
+	src, srcLevel, ok := p.levels.closest(key, level, maxLevel)
+	if !ok {
+		decodePath, err := resolveDecodePath(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if src, err = p.decodeLevel(ctx, key, maxLevel, decodePath); err != nil {
+			return nil, err
+		}
+		srcLevel = maxLevel
+	}
+	if srcLevel == level {
+		return src, nil
+	}
+
+	if err := p.scheduler.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.scheduler.Release()
+
+	levelImg := src.image
+	for l := srcLevel - 1; l >= level; l-- {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// 每级缩小一半并向上取整，与 TileLevelSize 一致，逐级缩小比直接从原图缩小快得多
+		b := levelImg.Bounds()
+		levelImg = resizeTo(levelImg, max(1, (b.Dx()+1)/2), max(1, (b.Dy()+1)/2))
+	}
+	return p.levels.put(key, level, levelImg, src.format), nil
+}
+
+// decodeLevel 解码原图生成原始尺寸的层级
+func (p *Processor) decodeLevel(ctx context.Context, key string, maxLevel int, decodePath string) (*levelEntry, error) {
+	if err := p.scheduler.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.scheduler.Release()
+
+	src, err := decode(ctx, decodePath)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	resized := resizeTo(src.image, src.image.Bounds().Dx(), src.image.Bounds().Dy())
+	src.toSRGB(resized)
+	levelImg := applyOrientation(resized, src.orientation)
+	return p.levels.put(key, maxLevel, levelImg, outputFormat(levelImg)), nil
+}
+
+// saveTile 编码层级中的一个瓦片保存
+func (p *Processor) saveTile(levelImg *levelEntry, tileKey string, tileSize, col, row int) (string, error) {
+	levelWidth, levelHeight := levelImg.image.Bounds().Dx(), levelImg.image.Bounds().Dy()
+	const overlap = appimage.TileOverlap
+	rect := image.Rect(
+		max(0, col*tileSize-overlap),
+		max(0, row*tileSize-overlap),
+		min(levelWidth, (col+1)*tileSize+overlap),
+		min(levelHeight, (row+1)*tileSize+overlap),
+	)
+	return p.cache.Save(tileKey+levelImg.format.Meta().Extension, func(f *os.File) error {
+		return encode(f, levelImg.image.SubImage(rect), levelImg.format, tileQuality)
+	})
+}
+
+var _ appimage.TileProcessor = (*Processor)(nil)
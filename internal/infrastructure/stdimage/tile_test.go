@@ -0,0 +1,163 @@
+package stdimage
+
+import (
+	"context"
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appimage "main/internal/application/image"
+	"main/internal/infrastructure/localfs"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessor_Tile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.jpg")
+	writeTestJPEG(t, src, 300, 200, 0)
+
+	p := NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	ctx := context.Background()
+	maxLevel := appimage.TileMaxLevel(300, 200)
+	require.Equal(t, 9, maxLevel)
+
+	// 原始尺寸，中间瓦片两侧都有重叠
+	out, err := p.Tile(ctx, src, 128, maxLevel, 1, 0)
+	require.NoError(t, err)
+	img, _ := decodeFile(t, out)
+	assert.Equal(t, image.Rect(0, 0, 128+2*appimage.TileOverlap, 128+appimage.TileOverlap), img.Bounds())
+
+	// 最后一列只剩余部分像素
+	out, err = p.Tile(ctx, src, 128, maxLevel, 2, 1)
+	require.NoError(t, err)
+	img, _ = decodeFile(t, out)
+	assert.Equal(t, image.Rect(0, 0, 300-256+appimage.TileOverlap, 200-128+appimage.TileOverlap), img.Bounds())
+
+	// 缩小一级为 150x100，只有一个瓦片
+	out, err = p.Tile(ctx, src, 128, maxLevel-1, 0, 0)
+	require.NoError(t, err)
+	img, _ = decodeFile(t, out)
+	assert.Equal(t, image.Rect(0, 0, 128+appimage.TileOverlap, 100), img.Bounds())
+
+	_, err = p.Tile(ctx, src, 128, maxLevel-1, 2, 0)
+	assert.ErrorIs(t, err, appimage.ErrTileNotFound)
+	_, err = p.Tile(ctx, src, 128, maxLevel+1, 0, 0)
+	assert.ErrorIs(t, err, appimage.ErrTileNotFound)
+}
+
+func TestProcessor_Tile_Orientation(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.jpg")
+	writeTestJPEG(t, src, 300, 200, 6)
+
+	p := NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	out, err := p.Tile(context.Background(), src, 512, appimage.TileMaxLevel(200, 300), 0, 0)
+	require.NoError(t, err)
+	img, _ := decodeFile(t, out)
+	assert.Equal(t, image.Rect(0, 0, 200, 300), img.Bounds(), "Tiles should be in display orientation")
+}
+
+func TestProcessor_Tile_DecodeOnce(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.jpg")
+	writeTestJPEG(t, src, 300, 200, 0)
+
+	p := NewProcessor(&mockCache{dir: t.TempDir()}, localfs.NewPathCacheKeyer(), 1)
+	ctx := context.Background()
+	var decodes int
+	resolveDecodePath := func(context.Context) (string, error) {
+		decodes++
+		return src, nil
+	}
+	meta := func(context.Context) (*shared.ImageMeta, error) {
+		return imageMeta(src)
+	}
+
+	// 层级和行列不在签名中，超出范围时不解码
+	for _, tc := range [][3]int{{1 << 20, 0, 0}, {10, 0, 0}, {9, 3, 0}, {9, 0, 2}, {0, 1, 0}} {
+		_, err := p.tile(ctx, src, resolveDecodePath, meta, 128, tc[0], tc[1], tc[2])
+		assert.ErrorIs(t, err, appimage.ErrTileNotFound, tc)
+	}
+	assert.Equal(t, 0, decodes)
+
+	for level := appimage.TileMaxLevel(300, 200); level >= 0; level-- {
+		out, err := p.tile(ctx, src, resolveDecodePath, meta, 128, level, 0, 0)
+		require.NoError(t, err)
+		img, _ := decodeFile(t, out)
+		w, h := appimage.TileLevelSize(300, 200, level)
+		assert.Equal(t, image.Rect(0, 0, min(w, 128+appimage.TileOverlap), min(h, 128+appimage.TileOverlap)), img.Bounds(), level)
+	}
+	assert.Equal(t, 1, decodes, "All levels should be generated from one decode")
+}
+
+func TestProcessor_Tile_OnlyRequested(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "a.jpg")
+	writeTestJPEG(t, src, 300, 200, 0)
+
+	cacheDir := t.TempDir()
+	p := NewProcessor(&mockCache{dir: cacheDir}, localfs.NewPathCacheKeyer(), 1)
+	maxLevel := appimage.TileMaxLevel(300, 200)
+
+	// 调用方取消时不生成瓦片，之后的请求重新生成
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := p.Tile(ctx, src, 128, maxLevel, 0, 0)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, countFiles(t, cacheDir))
+
+	_, err = p.Tile(context.Background(), src, 128, maxLevel, 0, 0)
+	require.NoError(t, err)
+	_, err = p.Tile(context.Background(), src, 128, maxLevel-2, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, countFiles(t, cacheDir), "Only the requested tiles should be encoded")
+}
+
+func countFiles(t *testing.T, dir string) int {
+	t.Helper()
+	var n int
+	require.NoError(t, filepath.WalkDir(dir, func(_ string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			n++
+		}
+		return err
+	}))
+	return n
+}
+
+func TestLevelCache_Put(t *testing.T) {
+	newLevel := func(size int) *image.RGBA {
+		return image.NewRGBA(image.Rect(0, 0, size, size))
+	}
+	c := newLevelCache(int64(len(newLevel(10).Pix) * 2))
+
+	c.put("a", 2, newLevel(10), shared.ImageFormatJPEG)
+	c.put("a", 1, newLevel(10), shared.ImageFormatJPEG)
+	_, ok := c.get("a", 2)
+	assert.True(t, ok)
+
+	// 超过容量时淘汰最久未使用的层级
+	c.put("a", 0, newLevel(10), shared.ImageFormatJPEG)
+	_, ok = c.get("a", 1)
+	assert.False(t, ok)
+	e, level, ok := c.closest("a", 0, 2)
+	require.True(t, ok)
+	assert.Equal(t, 2, level)
+	assert.Equal(t, 10, e.image.Bounds().Dx())
+
+	// 超过容量的层级仍然保留，直到加入下一个层级
+	c.put("b", 1, newLevel(20), shared.ImageFormatJPEG)
+	_, ok = c.get("b", 1)
+	assert.True(t, ok)
+	_, ok = c.get("a", 2)
+	assert.False(t, ok)
+	c.put("b", 0, newLevel(10), shared.ImageFormatJPEG)
+	_, ok = c.get("b", 1)
+	assert.False(t, ok, "The oversized level should be freed once a smaller level exists")
+	_, ok = c.get("b", 0)
+	assert.True(t, ok)
+}
@@ -0,0 +1,169 @@
+// Package thumbhash 实现 ThumbHash 编码，用于图片加载前显示的模糊占位图
+//
+// 与 BlurHash 相比，ThumbHash 同时记录宽高比和透明度，通常只需要 20 多个字节。
+// 算法及客户端解码实现见 https://github.com/evanw/thumbhash
+package thumbhash
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+)
+
+// MaxSize 是输入图片的最大宽高，更大的图片需要先缩小
+const MaxSize = 100
+
+var ErrTooLarge = errors.New("thumbhash: image must fit in 100x100")
+
+// Encode 将 sRGB 图片编码为 ThumbHash
+func Encode(img image.Image) ([]byte, error) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > MaxSize || h > MaxSize {
+		return nil, ErrTooLarge
+	}
+	if w == 0 || h == 0 {
+		return nil, errors.New("thumbhash: empty image")
+	}
+
+	// 读取非预乘的颜色
+	n := w * h
+	rgba := make([][4]float64, n)
+	for y := range h {
+		for x := range w {
+			c := color.NRGBAModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.NRGBA)
+			rgba[x+y*w] = [4]float64{float64(c.R) / 255, float64(c.G) / 255, float64(c.B) / 255, float64(c.A) / 255}
+		}
+	}
+
+	// 计算平均颜色
+	var avgR, avgG, avgB, avgA float64
+	for _, c := range rgba {
+		avgR += c[3] * c[0]
+		avgG += c[3] * c[1]
+		avgB += c[3] * c[2]
+		avgA += c[3]
+	}
+	if avgA > 0 {
+		avgR /= avgA
+		avgG /= avgA
+		avgB /= avgA
+	}
+
+	hasAlpha := avgA < float64(n)
+	// 有透明度时减少亮度的分量
+	lLimit := 7.0
+	if hasAlpha {
+		lLimit = 5
+	}
+	maxSide := float64(max(w, h))
+	lx := max(1, int(round(lLimit*float64(w)/maxSide)))
+	ly := max(1, int(round(lLimit*float64(h)/maxSide)))
+
+	// 将 RGBA 转换为 LPQA（亮度、黄蓝、红绿、透明度），透明部分与平均颜色混合
+	l := make([]float64, n)
+	p := make([]float64, n)
+	q := make([]float64, n)
+	a := make([]float64, n)
+	for i, c := range rgba {
+		r := avgR*(1-c[3]) + c[3]*c[0]
+		g := avgG*(1-c[3]) + c[3]*c[1]
+		bl := avgB*(1-c[3]) + c[3]*c[2]
+		l[i] = (r + g + bl) / 3
+		p[i] = (r+g)/2 - bl
+		q[i] = r - g
+		a[i] = c[3]
+	}
+
+	lChannel := encodeChannel(l, w, h, max(3, lx), max(3, ly))
+	pChannel := encodeChannel(p, w, h, 3, 3)
+	qChannel := encodeChannel(q, w, h, 3, 3)
+
+	isLandscape := w > h
+	header24 := uint32(round(63*lChannel.dc)) |
+		uint32(round(31.5+31.5*pChannel.dc))<<6 |
+		uint32(round(31.5+31.5*qChannel.dc))<<12 |
+		uint32(round(31*lChannel.scale))<<18
+	if hasAlpha {
+		header24 |= 1 << 23
+	}
+	header16 := uint16(lx)
+	if isLandscape {
+		header16 = uint16(ly)
+	}
+	header16 |= uint16(round(63*pChannel.scale))<<3 | uint16(round(63*qChannel.scale))<<9
+	if isLandscape {
+		header16 |= 1 << 15
+	}
+
+	hash := []byte{
+		byte(header24), byte(header24 >> 8), byte(header24 >> 16),
+		byte(header16), byte(header16 >> 8),
+	}
+	channels := []channel{lChannel, pChannel, qChannel}
+	if hasAlpha {
+		aChannel := encodeChannel(a, w, h, 5, 5)
+		hash = append(hash, byte(round(15*aChannel.dc))|byte(round(15*aChannel.scale))<<4)
+		channels = append(channels, aChannel)
+	}
+
+	// 每个变化分量占 4 位
+	acStart := len(hash)
+	acIndex := 0
+	for _, c := range channels {
+		for _, f := range c.ac {
+			i := acStart + acIndex>>1
+			if i >= len(hash) {
+				hash = append(hash, 0)
+			}
+			hash[i] |= byte(round(15*f)) << ((acIndex & 1) << 2)
+			acIndex++
+		}
+	}
+	return hash, nil
+}
+
+// channel 是单个通道 DCT 后的常量分量和归一化后的变化分量
+type channel struct {
+	dc    float64
+	ac    []float64
+	scale float64
+}
+
+func encodeChannel(values []float64, w, h, nx, ny int) channel {
+	var c channel
+	fx := make([]float64, w)
+	for cy := range ny {
+		for cx := 0; cx*ny < nx*(ny-cy); cx++ {
+			for x := range w {
+				fx[x] = math.Cos(math.Pi / float64(w) * float64(cx) * (float64(x) + 0.5))
+			}
+			var f float64
+			for y := range h {
+				fy := math.Cos(math.Pi / float64(h) * float64(cy) * (float64(y) + 0.5))
+				for x := range w {
+					f += values[x+y*w] * fx[x] * fy
+				}
+			}
+			f /= float64(w * h)
+			if cx > 0 || cy > 0 {
+				c.ac = append(c.ac, f)
+				c.scale = max(c.scale, math.Abs(f))
+			} else {
+				c.dc = f
+			}
+		}
+	}
+	if c.scale > 0 {
+		for i := range c.ac {
+			c.ac[i] = 0.5 + 0.5/c.scale*c.ac[i]
+		}
+	}
+	return c
+}
+
+// round 与参考实现的 Math.round 一致，0.5 总是向上取整
+func round(v float64) float64 {
+	return math.Floor(v + 0.5)
+}
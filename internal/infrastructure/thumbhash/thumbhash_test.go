@@ -0,0 +1,100 @@
+package thumbhash
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// averageRGBA 按参考实现的 thumbHashToAverageRGBA 解码平均颜色
+func averageRGBA(hash []byte) (r, g, b, a float64) {
+	header := uint32(hash[0]) | uint32(hash[1])<<8 | uint32(hash[2])<<16
+	l := float64(header&63) / 63
+	p := float64((header>>6)&63)/31.5 - 1
+	q := float64((header>>12)&63)/31.5 - 1
+	a = 1
+	if header>>23 != 0 {
+		a = float64(hash[5]&15) / 15
+	}
+	b = l - 2.0/3*p
+	r = (3*l - b + q) / 2
+	g = r - q
+	clamp := func(v float64) float64 { return max(0, min(1, v)) }
+	return clamp(r), clamp(g), clamp(b), a
+}
+
+// aspectRatio 按参考实现的 thumbHashToApproximateAspectRatio 解码宽高比
+func aspectRatio(hash []byte) float64 {
+	hasAlpha := hash[2]&0x80 != 0
+	isLandscape := hash[4]&0x80 != 0
+	limit := 7.0
+	if hasAlpha {
+		limit = 5
+	}
+	if isLandscape {
+		return limit / float64(hash[3]&7)
+	}
+	return float64(hash[3]&7) / limit
+}
+
+func uniform(w, h int, c color.Color) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(img, img.Bounds(), image.NewUniform(c), image.Point{}, draw.Src)
+	return img
+}
+
+func TestEncode_Uniform(t *testing.T) {
+	hash, err := Encode(uniform(100, 50, color.NRGBA{R: 200, G: 100, B: 50, A: 255}))
+	require.NoError(t, err)
+
+	r, g, b, a := averageRGBA(hash)
+	assert.InDelta(t, 200.0/255, r, 0.03)
+	assert.InDelta(t, 100.0/255, g, 0.03)
+	assert.InDelta(t, 50.0/255, b, 0.03)
+	assert.Equal(t, 1.0, a)
+	assert.InDelta(t, 2.0, aspectRatio(hash), 0.4)
+	assert.LessOrEqual(t, len(hash), 25)
+}
+
+func TestEncode_Portrait(t *testing.T) {
+	hash, err := Encode(uniform(30, 90, color.White))
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0/3, aspectRatio(hash), 0.1)
+}
+
+func TestEncode_Alpha(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 40, 40))
+	draw.Draw(img, image.Rect(0, 0, 20, 40), image.NewUniform(color.NRGBA{B: 255, A: 255}), image.Point{}, draw.Src)
+
+	hash, err := Encode(img)
+	require.NoError(t, err)
+
+	assert.NotZero(t, hash[2]&0x80, "alpha flag should be set")
+	r, _, b, a := averageRGBA(hash)
+	assert.InDelta(t, 0.5, a, 0.1)
+	// 透明部分与平均颜色混合，平均颜色仍为蓝色
+	assert.Greater(t, b, r)
+}
+
+func TestEncode_Deterministic(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 48))
+	for y := range 48 {
+		for x := range 64 {
+			img.Set(x, y, color.RGBA{R: uint8(x * 4), G: uint8(y * 5), B: 128, A: 255})
+		}
+	}
+	first, err := Encode(img)
+	require.NoError(t, err)
+	second, err := Encode(img)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestEncode_TooLarge(t *testing.T) {
+	_, err := Encode(uniform(101, 10, color.Black))
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
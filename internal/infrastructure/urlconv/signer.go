@@ -25,6 +25,22 @@ func NewSigner(secretKey, rootDir string) *Signer {
 }
 
 func (s *Signer) GenerateSignedURL(path string, opts ...image.SignOption) (string, error) {
+	return s.generateSignedURL("image", path, opts...)
+}
+
+// GenerateSignedTileURL 生成瓦片地址，客户端追加 l（层级）、x、y 参数获取具体瓦片
+func (s *Signer) GenerateSignedTileURL(path string, tileSize int, opts ...image.SignOption) (string, error) {
+	return s.generateSignedURL("tile", path, append(opts, func(v url.Values) {
+		v.Set("ts", fmt.Sprintf("%d", tileSize))
+	})...)
+}
+
+// GenerateSignedVideoURL 生成视频原文件地址，支持 Range 请求
+func (s *Signer) GenerateSignedVideoURL(path string) (string, error) {
+	return s.generateSignedURL("video", path)
+}
+
+func (s *Signer) generateSignedURL(endpoint, path string, opts ...image.SignOption) (string, error) {
 	relativePath, err := s.toRelativePath(path)
 	if err != nil {
 		return "", err
@@ -48,15 +64,15 @@ func (s *Signer) GenerateSignedURL(path string, opts ...image.SignOption) (strin
 	params.Set("t", fmt.Sprintf("%d", timestamp))
 	params.Set("s", fmt.Sprintf("%d", size))
 
-	signatureBytes := s.calculateSignature(relativePath, fmt.Sprintf("%d", timestamp), fmt.Sprintf("%d", size), params.Get("w"), params.Get("q"))
+	signatureBytes := s.calculateSignature(relativePath, fmt.Sprintf("%d", timestamp), fmt.Sprintf("%d", size), params.Get("w"), params.Get("q"), params.Get("f"), params.Get("ts"), params.Get("o"))
 	params.Set("sig", base64.URLEncoding.EncodeToString(signatureBytes))
 
-	return fmt.Sprintf("image?%s", params.Encode()), nil
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode()), nil
 }
 
-func (s *Signer) calculateSignature(path, timestamp, size, w, q string) []byte {
+func (s *Signer) calculateSignature(path, timestamp, size, w, q, f, ts, o string) []byte {
 	mac := hmac.New(sha256.New, s.secretKey)
-	fmt.Fprintf(mac, "%s|%s|%s|%s|%s", path, timestamp, size, w, q)
+	fmt.Fprintf(mac, "%s|%s|%s|%s|%s|%s|%s|%s", path, timestamp, size, w, q, f, ts, o)
 	return mac.Sum(nil)
 }
 
@@ -99,12 +115,15 @@ func (s *Signer) ValidateRequestFromValues(params url.Values) error {
 	signature := params.Get("sig")
 	w := params.Get("w")
 	q := params.Get("q")
+	f := params.Get("f")
+	ts := params.Get("ts")
+	o := params.Get("o")
 
 	if path == "" || timestampStr == "" || sizeStr == "" || signature == "" {
 		return fmt.Errorf("missing required parameters")
 	}
 
-	expectedSignature := s.calculateSignature(path, timestampStr, sizeStr, w, q)
+	expectedSignature := s.calculateSignature(path, timestampStr, sizeStr, w, q, f, ts, o)
 	gotSignature, err := base64.URLEncoding.DecodeString(signature)
 	if err != nil {
 		return fmt.Errorf("invalid signature encoding")
@@ -3,8 +3,12 @@ package urlconv
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"main/internal/application/image"
+	"main/internal/shared"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,6 +48,59 @@ func TestValidateSignedURL(t *testing.T) {
 	assert.Equal(t, relPath, path)
 }
 
+func TestValidateSignedURL_Format(t *testing.T) {
+	rootDir := t.TempDir()
+	signer := NewSigner("test-secret-key", rootDir)
+
+	relPath := "test.jpg"
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, relPath), []byte("test"), 0644))
+
+	signedURL, err := signer.GenerateSignedURL(relPath, image.WithFormat(shared.ImageFormatJPEG))
+	require.NoError(t, err)
+	assert.Contains(t, signedURL, "f=JPEG")
+
+	_, err = signer.ValidateSignedURL(signedURL)
+	require.NoError(t, err)
+
+	_, err = signer.ValidateSignedURL(strings.Replace(signedURL, "f=JPEG", "f=AVIF", 1))
+	assert.Error(t, err, "Format should be part of the signature")
+}
+
+func TestGenerateSignedTileURL(t *testing.T) {
+	rootDir := t.TempDir()
+	signer := NewSigner("test-secret-key", rootDir)
+
+	relPath := "test.jpg"
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, relPath), []byte("test"), 0644))
+
+	tileURL, err := signer.GenerateSignedTileURL(relPath, 512)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(tileURL, "tile?"))
+
+	// 层级和行列不参与签名
+	_, err = signer.ValidateSignedURL(tileURL + "&l=3&x=1&y=2")
+	require.NoError(t, err)
+
+	_, err = signer.ValidateSignedURL(strings.Replace(tileURL, "ts=512", "ts=256", 1))
+	assert.Error(t, err, "Tile size should be part of the signature")
+}
+
+func TestGenerateSignedVideoURL(t *testing.T) {
+	rootDir := t.TempDir()
+	signer := NewSigner("test-secret-key", rootDir)
+
+	relPath := "test.mp4"
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, relPath), []byte("test"), 0644))
+
+	videoURL, err := signer.GenerateSignedVideoURL(relPath)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(videoURL, "video?"))
+
+	path, err := signer.ValidateSignedURL(videoURL)
+	require.NoError(t, err)
+	assert.Equal(t, relPath, path)
+}
+
 func TestToRelativePath(t *testing.T) {
 	signer := NewSigner("test-secret-key", t.TempDir())
 
@@ -0,0 +1,292 @@
+package xmpsidecar
+
+import (
+	"context"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"main/internal/apperror"
+	"main/internal/domain/metadata"
+	"main/internal/shared"
+
+	"github.com/beevik/etree"
+)
+
+const (
+	// backupSuffix 是原子写入时创建的备份后缀，写入成功后会被删除
+	backupSuffix = "~"
+	// brokenMarker 是写入时发现 sidecar 损坏而另存的文件名标记
+	brokenMarker = ".broken"
+)
+
+// Recovery 实现损坏 sidecar 的检查与修复
+type Recovery struct {
+	repo *Repository
+}
+
+// NewRecovery 创建 sidecar 修复工具
+func NewRecovery(repo *Repository) *Recovery {
+	return &Recovery{
+		repo: repo,
+	}
+}
+
+// Scan 递归列出目录下需要修复的文件，跳过隐藏目录
+func (r *Recovery) Scan(ctx context.Context, dir string) iter.Seq2[*metadata.SidecarIssue, error] {
+	return func(yield func(*metadata.SidecarIssue, error) bool) {
+		stopped := false
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if d.IsDir() {
+				if path != dir && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			issue, err := r.Inspect(path)
+			if err != nil {
+				if !yield(nil, err) {
+					stopped = true
+					return filepath.SkipAll
+				}
+				return nil
+			}
+			if issue != nil && !yield(issue, nil) {
+				stopped = true
+				return filepath.SkipAll
+			}
+			return nil
+		})
+		if err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+// Inspect 检查单个文件，返回 (nil, nil) 表示没有问题
+func (r *Recovery) Inspect(path string) (*metadata.SidecarIssue, error) {
+	kind, imagePath, ok := classify(path)
+	if !ok {
+		return nil, nil
+	}
+
+	content, revision, err := readXMPFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if kind != shared.SidecarIssueKindUnparseable {
+		// 备份文件的修订版本不是 sidecar 的
+		if revision, err = r.repo.Revision(imagePath); err != nil {
+			return nil, err
+		}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var parseErr error
+	if err := etree.NewDocument().ReadFromBytes(content); err != nil {
+		parseErr = err
+	}
+	if kind == shared.SidecarIssueKindUnparseable && parseErr == nil {
+		// 正常的 sidecar
+		return nil, nil
+	}
+
+	var salvageableRating *int
+	if rating, ok := salvageRating(content); ok {
+		salvageableRating = &rating
+	}
+
+	return metadata.NewSidecarIssue(kind, path, imagePath, revision, info.ModTime(), parseErr, salvageableRating), nil
+}
+
+// Restore 用备份替换 sidecar
+//
+// 无法解析的 sidecar 使用同名 `~` 备份恢复，`.broken` 文件本身已损坏，不支持恢复
+func (r *Recovery) Restore(issue *metadata.SidecarIssue) error {
+	xmpPath := issue.ImagePath() + ".xmp"
+
+	var backupPath string
+	switch issue.Kind() {
+	case shared.SidecarIssueKindBackup:
+		backupPath = issue.Path()
+	case shared.SidecarIssueKindUnparseable:
+		backupPath = xmpPath + backupSuffix
+		if _, err := os.Stat(backupPath); os.IsNotExist(err) {
+			return apperror.New(
+				"SIDECAR_BACKUP_NOT_FOUND",
+				"no backup available for sidecar: "+xmpPath,
+				"sidecar 没有可用的备份: "+xmpPath,
+			)
+		}
+	default:
+		return apperror.New(
+			"INVALID_OPERATION",
+			"broken sidecar copy can not be restored, salvage or discard it instead",
+			"损坏的 sidecar 副本无法恢复，请尝试抢救或丢弃",
+		)
+	}
+
+	if err := r.checkRevision(issue); err != nil {
+		return err
+	}
+
+	// 避免用损坏的备份覆盖 sidecar
+	content, _, err := readXMPFile(backupPath)
+	if err != nil {
+		return err
+	}
+	if err := etree.NewDocument().ReadFromBytes(content); err != nil {
+		return apperror.New(
+			"SIDECAR_BACKUP_BROKEN",
+			"sidecar backup is broken: "+backupPath,
+			"sidecar 备份已损坏: "+backupPath,
+		)
+	}
+
+	return os.Rename(backupPath, xmpPath)
+}
+
+// Salvage 宽松地从问题文件中提取评分并写入 sidecar，返回提取到的评分
+//
+// 通过仓库写入，无法解析的 sidecar 会先另存为 `.broken` 文件，不会丢失数据
+func (r *Recovery) Salvage(issue *metadata.SidecarIssue) (int, error) {
+	content, _, err := readXMPFile(issue.Path())
+	if err != nil {
+		return 0, err
+	}
+	rating, ok := salvageRating(content)
+	if !ok {
+		return 0, apperror.New(
+			"SIDECAR_SALVAGE_FAILED",
+			"no rating found in file: "+issue.Path(),
+			"文件中未找到评分: "+issue.Path(),
+		)
+	}
+
+	// 尽量保留 sidecar 中已有的操作
+	var action string
+	if current, err := r.repo.Read(issue.ImagePath()); err == nil {
+		action = current.Action()
+	} else {
+		action = salvageAction(content)
+	}
+
+	if err := r.repo.Write(
+		issue.ImagePath(),
+		metadata.NewXMPData(rating, action, time.Now()),
+		metadata.WithExpectedRevision(issue.SidecarRevision()),
+	); err != nil {
+		return 0, err
+	}
+	return rating, nil
+}
+
+// checkRevision 检查 sidecar 在检查问题后是否被修改
+func (r *Recovery) checkRevision(issue *metadata.SidecarIssue) error {
+	revision, err := r.repo.Revision(issue.ImagePath())
+	if err != nil {
+		return err
+	}
+	if revision != issue.SidecarRevision() {
+		return metadata.NewErrXMPConflict(issue.ImagePath())
+	}
+	return nil
+}
+
+// Discard 删除问题文件
+func (r *Recovery) Discard(issue *metadata.SidecarIssue) error {
+	if issue.Kind() == shared.SidecarIssueKindUnparseable {
+		// 问题文件就是 sidecar，检查后被修复时不应删除
+		if err := r.checkRevision(issue); err != nil {
+			return err
+		}
+	}
+	return os.Remove(issue.Path())
+}
+
+// classify 根据文件名判断是否为 sidecar 相关文件，并返回所属图片的路径
+func classify(path string) (kind shared.SidecarIssueKind, imagePath string, ok bool) {
+	name := strings.ToLower(filepath.Base(path))
+	switch {
+	case strings.HasSuffix(name, ".xmp"+backupSuffix):
+		return shared.SidecarIssueKindBackup, path[:len(path)-len(".xmp"+backupSuffix)], true
+	case strings.HasSuffix(name, ".xmp"):
+		return shared.SidecarIssueKindUnparseable, path[:len(path)-len(".xmp")], true
+	}
+
+	// .xmp.broken<纳秒时间戳>
+	index := strings.LastIndex(name, ".xmp"+brokenMarker)
+	if index < 0 {
+		return
+	}
+	suffix := name[index+len(".xmp"+brokenMarker):]
+	if _, err := strconv.ParseInt(suffix, 10, 64); err != nil {
+		return
+	}
+	dirLen := len(path) - len(name)
+	return shared.SidecarIssueKindBrokenBackup, path[:dirLen+index], true
+}
+
+// #region Lenient Parsing
+
+// lenientRatingPattern 匹配属性或元素形式的评分，不依赖 XML 结构完整
+var lenientRatingPattern = regexp.MustCompile(`([\w.-]+):Rating\s*(?:=\s*["']\s*(-?\d+)|>\s*(-?\d+)\s*<)`)
+
+// lenientActionPattern 匹配 ImageFunnel 写入的操作
+var lenientActionPattern = regexp.MustCompile(`ImageFunnel:Action\s*(?:=\s*["']\s*(\w+)|>\s*(\w+)\s*<)`)
+
+// salvageRating 从可能已损坏的内容中提取评分
+//
+// 无法解析命名空间，只识别常用前缀，优先使用 xmp:Rating
+// （编辑历史中也有 ImageFunnel:Rating，不能使用）
+func salvageRating(content []byte) (int, bool) {
+	var msRating *int
+	for _, match := range lenientRatingPattern.FindAllSubmatch(content, -1) {
+		valStr := string(match[2]) + string(match[3])
+		val, err := strconv.Atoi(valStr)
+		if err != nil {
+			continue
+		}
+		switch string(match[1]) {
+		case "xmp":
+			return val, true
+		case "MicrosoftPhoto":
+			if msRating == nil {
+				converted := fromMicrosoftRating(val)
+				msRating = &converted
+			}
+		}
+	}
+	if msRating != nil {
+		return *msRating, true
+	}
+	return 0, false
+}
+
+// salvageAction 从可能已损坏的内容中提取 ImageFunnel 操作，找不到时返回空字符串
+func salvageAction(content []byte) string {
+	match := lenientActionPattern.FindSubmatch(content)
+	if match == nil {
+		return ""
+	}
+	return string(match[1]) + string(match[2])
+}
+
+// #endregion
+
+var _ metadata.Recovery = (*Recovery)(nil)
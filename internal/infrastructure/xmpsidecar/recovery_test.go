@@ -0,0 +1,179 @@
+package xmpsidecar
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"main/internal/domain/metadata"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const brokenXMP = `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description xmlns:xmp="http://ns.adobe.com/xap/1.0/" xmp:Rating="4"
+   xmlns:ImageFunnel="https://github.com/NateScarlet/image-funnel" ImageFunnel:Action="keep">
+  </rdf:Description
+`
+
+func TestRecovery_Scan(t *testing.T) {
+	repo := NewRepository()
+	recovery := NewRecovery(repo)
+	dir := t.TempDir()
+
+	require.NoError(t, repo.Write(filepath.Join(dir, "ok.jpg"), metadata.NewXMPData(3, "", time.Now())))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.jpg.xmp"), []byte(brokenXMP), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "a.jpg.xmp~"), []byte(brokenXMP), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "a.jpg.xmp.broken123"), []byte(brokenXMP), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".hidden"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden", "b.jpg.xmp"), []byte(brokenXMP), 0644))
+
+	kinds := make(map[string]shared.SidecarIssueKind)
+	for issue, err := range recovery.Scan(context.Background(), dir) {
+		require.NoError(t, err)
+		rel, err := filepath.Rel(dir, issue.Path())
+		require.NoError(t, err)
+		kinds[filepath.ToSlash(rel)] = issue.Kind()
+
+		require.NotNil(t, issue.SalvageableRating())
+		assert.Equal(t, 4, *issue.SalvageableRating())
+	}
+
+	assert.Equal(t, map[string]shared.SidecarIssueKind{
+		"broken.jpg.xmp":          shared.SidecarIssueKindUnparseable,
+		"sub/a.jpg.xmp~":          shared.SidecarIssueKindBackup,
+		"sub/a.jpg.xmp.broken123": shared.SidecarIssueKindBrokenBackup,
+	}, kinds)
+}
+
+func TestRecovery_Inspect_ImagePath(t *testing.T) {
+	recovery := NewRecovery(NewRepository())
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.JPG.XMP.broken123")
+	require.NoError(t, os.WriteFile(path, []byte(brokenXMP), 0644))
+
+	issue, err := recovery.Inspect(path)
+	require.NoError(t, err)
+	require.NotNil(t, issue)
+	assert.Equal(t, filepath.Join(dir, "a.JPG"), issue.ImagePath())
+	assert.Error(t, issue.Err())
+}
+
+func TestRecovery_Restore(t *testing.T) {
+	repo := NewRepository()
+	recovery := NewRecovery(repo)
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "a.jpg")
+	xmpPath := imagePath + ".xmp"
+
+	require.NoError(t, repo.Write(imagePath, metadata.NewXMPData(2, "", time.Now())))
+	require.NoError(t, os.Rename(xmpPath, xmpPath+"~"))
+	require.NoError(t, os.WriteFile(xmpPath, []byte(brokenXMP), 0644))
+
+	issue, err := recovery.Inspect(xmpPath)
+	require.NoError(t, err)
+	require.NotNil(t, issue)
+	require.NoError(t, recovery.Restore(issue))
+
+	data, err := repo.Read(imagePath)
+	require.NoError(t, err)
+	assert.Equal(t, 2, data.Rating())
+	assert.NoFileExists(t, xmpPath+"~")
+}
+
+func TestRecovery_Restore_NoBackup(t *testing.T) {
+	recovery := NewRecovery(NewRepository())
+	dir := t.TempDir()
+	xmpPath := filepath.Join(dir, "a.jpg.xmp")
+	require.NoError(t, os.WriteFile(xmpPath, []byte(brokenXMP), 0644))
+
+	issue, err := recovery.Inspect(xmpPath)
+	require.NoError(t, err)
+	assert.Error(t, recovery.Restore(issue))
+
+	content, err := os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.Equal(t, brokenXMP, string(content), "Sidecar should be left untouched")
+}
+
+func TestRecovery_Salvage(t *testing.T) {
+	repo := NewRepository()
+	recovery := NewRecovery(repo)
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "a.jpg")
+	xmpPath := imagePath + ".xmp"
+	require.NoError(t, os.WriteFile(xmpPath, []byte(brokenXMP), 0644))
+
+	issue, err := recovery.Inspect(xmpPath)
+	require.NoError(t, err)
+	rating, err := recovery.Salvage(issue)
+	require.NoError(t, err)
+	assert.Equal(t, 4, rating)
+
+	data, err := repo.Read(imagePath)
+	require.NoError(t, err)
+	assert.Equal(t, 4, data.Rating())
+	assert.Equal(t, "keep", data.Action())
+
+	// 原文件另存为 .broken，不会丢失
+	matches, err := filepath.Glob(xmpPath + ".broken*")
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
+
+func TestRecovery_ModifiedAfterInspect(t *testing.T) {
+	repo := NewRepository()
+	recovery := NewRecovery(repo)
+	dir := t.TempDir()
+	imagePath := filepath.Join(dir, "a.jpg")
+	xmpPath := imagePath + ".xmp"
+	require.NoError(t, os.WriteFile(xmpPath, []byte(brokenXMP), 0644))
+	require.NoError(t, os.WriteFile(xmpPath+"~", []byte(brokenXMP), 0644))
+
+	issue, err := recovery.Inspect(xmpPath)
+	require.NoError(t, err)
+	backupIssue, err := recovery.Inspect(xmpPath + "~")
+	require.NoError(t, err)
+	assert.Equal(t, issue.SidecarRevision(), backupIssue.SidecarRevision())
+
+	// 检查后 sidecar 被外部工具修复
+	require.NoError(t, repo.Write(imagePath, metadata.NewXMPData(1, "", time.Now())))
+
+	_, err = recovery.Salvage(issue)
+	assert.True(t, metadata.IsXMPConflict(err))
+	assert.True(t, metadata.IsXMPConflict(recovery.Restore(backupIssue)))
+	assert.True(t, metadata.IsXMPConflict(recovery.Discard(issue)))
+
+	data, err := repo.Read(imagePath)
+	require.NoError(t, err)
+	assert.Equal(t, 1, data.Rating())
+}
+
+func TestSalvageRating(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    int
+		wantOK  bool
+	}{
+		{"attribute", `<x xmp:Rating="3"`, 3, true},
+		{"element", `<xmp:Rating> -1 </xmp:Rating>`, -1, true},
+		{"microsoft", `<MicrosoftPhoto:Rating>75</MicrosoftPhoto:Rating>`, 4, true},
+		{"prefer xmp", `MicrosoftPhoto:Rating="99" xmp:Rating="2"`, 2, true},
+		{"ignore history", `<ImageFunnel:Rating>5</ImageFunnel:Rating>`, 0, false},
+		{"none", `<garbage`, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := salvageRating([]byte(tc.content))
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
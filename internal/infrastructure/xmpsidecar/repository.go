@@ -2,6 +2,7 @@ package xmpsidecar
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -18,18 +19,43 @@ const (
 	RDFNamespace     = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
 	ImageFunnelNS    = "https://github.com/NateScarlet/image-funnel/ns/1.0/"
 	MicrosoftPhotoNS = "http://ns.microsoft.com/photo/1.0/"
+	DCNamespace      = "http://purl.org/dc/elements/1.1/"
+	XMPMMNamespace   = "http://ns.adobe.com/xap/1.0/mm/"
+	StEvtNamespace   = "http://ns.adobe.com/xap/1.0/sType/ResourceEvent#"
+	TIFFNamespace    = "http://ns.adobe.com/tiff/1.0/"
 )
 
-type Repository struct{}
+// DefaultSoftwareAgent 是未指定时写入编辑历史的软件名称
+const DefaultSoftwareAgent = "ImageFunnel"
 
-func NewRepository() *Repository {
-	return &Repository{}
+type Repository struct {
+	softwareAgent string
+}
+
+// RepositoryOption 用于配置 Repository
+type RepositoryOption func(*Repository)
+
+// WithSoftwareAgent 设置写入编辑历史（stEvt:softwareAgent）的软件名称及版本
+func WithSoftwareAgent(softwareAgent string) RepositoryOption {
+	return func(r *Repository) {
+		r.softwareAgent = softwareAgent
+	}
+}
+
+func NewRepository(options ...RepositoryOption) *Repository {
+	r := &Repository{
+		softwareAgent: DefaultSoftwareAgent,
+	}
+	for _, opt := range options {
+		opt(r)
+	}
+	return r
 }
 
 func (r *Repository) Read(imagePath string) (*metadata.XMPData, error) {
 	xmpPath := imagePath + ".xmp"
 
-	data, err := os.ReadFile(xmpPath)
+	data, revision, err := readXMPFile(xmpPath)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -39,7 +65,7 @@ func (r *Repository) Read(imagePath string) (*metadata.XMPData, error) {
 
 	doc := etree.NewDocument()
 	if err := doc.ReadFromBytes(data); err != nil {
-		return nil, fmt.Errorf("failed to parse XMP: %w", err)
+		return nil, metadata.NewUnparseableError(revision, err)
 	}
 
 	localResult := &XMPData{rating: 0}
@@ -79,22 +105,59 @@ func (r *Repository) Read(imagePath string) (*metadata.XMPData, error) {
 				localResult.timestamp = val
 			}
 		}
+
+		if valStr, ok := getLangAltValue(rdf, DCNamespace, "description"); ok {
+			localResult.note = valStr
+			localResult.hasNote = true
+		}
+
+		if valStr, ok := getValueByNamespace(rdf, TIFFNamespace, "Orientation"); ok {
+			if val, err := strconv.Atoi(strings.TrimSpace(valStr)); err == nil && val >= 1 && val <= 8 {
+				localResult.orientation = val
+			}
+		}
+
+		localResult.history = append(localResult.history, readHistory(rdf)...)
+	}
+
+	options := []metadata.XMPDataOption{metadata.WithRevision(revision)}
+	if localResult.hasNote {
+		options = append(options, metadata.WithNote(localResult.note))
+	}
+	if localResult.orientation != 0 {
+		options = append(options, metadata.WithOrientation(localResult.orientation))
+	}
+	if len(localResult.history) > 0 {
+		options = append(options, metadata.WithHistory(localResult.history))
 	}
 
 	return metadata.NewXMPData(
 		localResult.rating,
 		localResult.action,
 		localResult.timestamp,
+		options...,
 	), nil
 }
 
 // #region Write
-func (r *Repository) Write(imagePath string, data *metadata.XMPData) error {
+func (r *Repository) Write(imagePath string, data *metadata.XMPData, options ...metadata.WriteOption) error {
 	xmpPath := imagePath + ".xmp"
+	opts := metadata.NewWriteOptions(options...)
+
+	existingData, revision, err := readXMPFile(xmpPath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read existing XMP: %w", err)
+	}
+
+	// 乐观并发控制：读取后 sidecar 被其他工具或实例修改时拒绝写入，避免静默覆盖
+	if expected, ok := opts.ExpectedRevision(); ok && expected != revision {
+		return metadata.NewErrXMPConflict(imagePath)
+	}
 
 	doc := etree.NewDocument()
 	// 加载已有文件
-	if existingData, err := os.ReadFile(xmpPath); err == nil {
+	if exists {
 		if err := doc.ReadFromBytes(existingData); err != nil {
 			// 如果解析失败，备份原文件并创建新文档
 			backupPath := fmt.Sprintf("%s.broken%d", xmpPath, time.Now().UnixNano())
@@ -103,8 +166,6 @@ func (r *Repository) Write(imagePath string, data *metadata.XMPData) error {
 			}
 			doc = etree.NewDocument()
 		}
-	} else if !os.IsNotExist(err) {
-		return fmt.Errorf("failed to read existing XMP: %w", err)
 	}
 
 	// 确保基础结构存在
@@ -159,6 +220,38 @@ func (r *Repository) Write(imagePath string, data *metadata.XMPData) error {
 	setValueByNamespace(desc, ImageFunnelNS, "ImageFunnel", "Action", data.Action())
 	setValueByNamespace(desc, ImageFunnelNS, "ImageFunnel", "Timestamp", data.Timestamp().Format(time.RFC3339))
 
+	// 备注只替换 x-default 项，保留其他工具写入的多语言描述
+	if data.HasNote() {
+		if data.Note() != "" {
+			ensureNamespace(desc, "dc", DCNamespace)
+		}
+		setLangAltValue(desc, DCNamespace, "dc", "description", data.Note())
+	}
+
+	// 方向为 0 时删除，恢复使用原图中的方向
+	if data.HasOrientation() {
+		if data.Orientation() != 0 {
+			ensureNamespace(desc, "tiff", TIFFNamespace)
+			setValueByNamespace(desc, TIFFNamespace, "tiff", "Orientation", strconv.Itoa(data.Orientation()))
+		} else {
+			removeValueByNamespace(desc, TIFFNamespace, "Orientation")
+		}
+	}
+
+	// 每次写入都追加编辑历史，便于追溯多轮筛选的结果
+	historyAction := data.Action()
+	if opts.HistoryAction() != "" {
+		historyAction = opts.HistoryAction()
+	}
+	rating := data.Rating()
+	appendHistory(desc, metadata.HistoryEvent{
+		Action:        historyAction,
+		Rating:        &rating,
+		Timestamp:     data.Timestamp(),
+		SoftwareAgent: r.softwareAgent,
+		SessionID:     data.SessionID(),
+	})
+
 	return writeXMPFile(doc, xmpPath)
 }
 
@@ -175,6 +268,11 @@ type XMPData struct {
 	rating    int
 	action    string
 	timestamp time.Time
+	note      string
+	hasNote   bool
+	history   []metadata.HistoryEvent
+
+	orientation int
 }
 
 func resolveNamespace(elem *etree.Element, prefix string) string {
@@ -282,6 +380,247 @@ func setValueByNamespace(elem *etree.Element, nsURL, preferredPrefix, localName,
 	child.SetText(value)
 }
 
+// removeValueByNamespace 删除简单值属性，属性形式和子元素形式都会删除
+func removeValueByNamespace(elem *etree.Element, nsURL, localName string) {
+	for _, attr := range elem.Attr {
+		prefix, local := attr.Space, attr.Key
+		if prefix == "" {
+			prefix, local = splitTag(attr.Key)
+		}
+		if local == localName && prefix != "" && resolveNamespace(elem, prefix) == nsURL {
+			elem.RemoveAttr(attr.FullKey())
+			break
+		}
+	}
+	for child := findChildByNamespace(elem, nsURL, localName); child != nil; child = findChildByNamespace(elem, nsURL, localName) {
+		elem.RemoveChild(child)
+	}
+}
+
+// #region Language Alternative
+
+// xDefaultLang 是 rdf:Alt 中默认语言项的 xml:lang 值
+const xDefaultLang = "x-default"
+
+// findChildByNamespace 查找指定命名空间和本地名称的子元素
+func findChildByNamespace(elem *etree.Element, nsURL, localName string) *etree.Element {
+	for _, child := range elem.ChildElements() {
+		if child.Tag == localName && resolveNamespace(child, child.Space) == nsURL {
+			return child
+		}
+	}
+	return nil
+}
+
+// getLangAltValue 读取语言可选值（rdf:Alt）属性，优先返回 x-default 项
+//
+// 兼容部分工具直接写为简单值的情况
+func getLangAltValue(elem *etree.Element, nsURL, localName string) (string, bool) {
+	prop := findChildByNamespace(elem, nsURL, localName)
+	if prop == nil {
+		return getValueByNamespace(elem, nsURL, localName)
+	}
+
+	alt := findChildByNamespace(prop, RDFNamespace, "Alt")
+	if alt == nil {
+		return strings.TrimSpace(prop.Text()), true
+	}
+
+	var first *etree.Element
+	for _, li := range alt.ChildElements() {
+		if li.Tag != "li" || resolveNamespace(li, li.Space) != RDFNamespace {
+			continue
+		}
+		if li.SelectAttrValue("xml:lang", "") == xDefaultLang {
+			return li.Text(), true
+		}
+		if first == nil {
+			first = li
+		}
+	}
+	if first != nil {
+		return first.Text(), true
+	}
+	return "", false
+}
+
+// setLangAltValue 设置语言可选值（rdf:Alt）属性的 x-default 项，其他语言项保持不变
+//
+// value 为空时如果没有其他语言项则移除整个属性，否则保留空的 x-default 项，
+// 避免读取时回退到其他语言项，使清除的备注重新出现
+func setLangAltValue(elem *etree.Element, nsURL, preferredPrefix, localName, value string) {
+	// 简单值形式无法保留语言信息，统一转换为 rdf:Alt
+	for _, attr := range elem.Attr {
+		prefix, local := attr.Space, attr.Key
+		if prefix == "" {
+			prefix, local = splitTag(attr.Key)
+		}
+		if local == localName && prefix != "" && resolveNamespace(elem, prefix) == nsURL {
+			elem.RemoveAttr(attr.FullKey())
+			break
+		}
+	}
+
+	prop := findChildByNamespace(elem, nsURL, localName)
+	if prop == nil {
+		if value == "" {
+			return
+		}
+		tagName := localName
+		if preferredPrefix != "" {
+			tagName = preferredPrefix + ":" + localName
+		}
+		prop = elem.CreateElement(tagName)
+	}
+
+	alt := findChildByNamespace(prop, RDFNamespace, "Alt")
+	if alt == nil {
+		// 丢弃简单值文本，替换为 rdf:Alt
+		for len(prop.Child) > 0 {
+			prop.RemoveChildAt(0)
+		}
+		alt = prop.CreateElement("rdf:Alt")
+	}
+
+	var defaultItem *etree.Element
+	for _, li := range alt.ChildElements() {
+		if li.Tag == "li" && li.SelectAttrValue("xml:lang", "") == xDefaultLang {
+			defaultItem = li
+			break
+		}
+	}
+
+	if value == "" && (len(alt.ChildElements()) == 0 || (defaultItem != nil && len(alt.ChildElements()) == 1)) {
+		elem.RemoveChild(prop)
+		return
+	}
+
+	if defaultItem == nil {
+		// XMP 规范要求 x-default 为第一项
+		defaultItem = etree.NewElement("rdf:li")
+		defaultItem.CreateAttr("xml:lang", xDefaultLang)
+		alt.InsertChildAt(0, defaultItem)
+	}
+	defaultItem.SetText(value)
+}
+
+// #endregion
+
+// #region History
+
+// readHistory 读取 xmpMM:History 中的事件，兼容属性和子元素两种写法
+func readHistory(desc *etree.Element) []metadata.HistoryEvent {
+	prop := findChildByNamespace(desc, XMPMMNamespace, "History")
+	if prop == nil {
+		return nil
+	}
+	seq := findChildByNamespace(prop, RDFNamespace, "Seq")
+	if seq == nil {
+		return nil
+	}
+
+	var result []metadata.HistoryEvent
+	for _, li := range seq.ChildElements() {
+		if li.Tag != "li" || resolveNamespace(li, li.Space) != RDFNamespace {
+			continue
+		}
+		// 部分工具会在 rdf:li 内嵌套 rdf:Description
+		item := li
+		if nested := findChildByNamespace(li, RDFNamespace, "Description"); nested != nil {
+			item = nested
+		}
+
+		var event metadata.HistoryEvent
+		event.Action, _ = getValueByNamespace(item, StEvtNamespace, "action")
+		event.SoftwareAgent, _ = getValueByNamespace(item, StEvtNamespace, "softwareAgent")
+		if valStr, ok := getValueByNamespace(item, StEvtNamespace, "when"); ok {
+			if val, err := time.Parse(time.RFC3339, valStr); err == nil {
+				event.Timestamp = val
+			}
+		}
+		if valStr, ok := getValueByNamespace(item, ImageFunnelNS, "Rating"); ok {
+			if val, err := strconv.Atoi(valStr); err == nil {
+				event.Rating = &val
+			}
+		}
+		event.SessionID, _ = getValueByNamespace(item, ImageFunnelNS, "SessionID")
+		result = append(result, event)
+	}
+	return result
+}
+
+// appendHistory 在 xmpMM:History 末尾追加一条事件
+//
+// 标准字段使用 stEvt 命名空间以便其他工具读取，评分和会话 ID 另存于 ImageFunnel 命名空间
+func appendHistory(desc *etree.Element, event metadata.HistoryEvent) {
+	ensureNamespace(desc, "xmpMM", XMPMMNamespace)
+	ensureNamespace(desc, "stEvt", StEvtNamespace)
+
+	prop := findChildByNamespace(desc, XMPMMNamespace, "History")
+	if prop == nil {
+		prop = desc.CreateElement("xmpMM:History")
+	}
+	seq := findChildByNamespace(prop, RDFNamespace, "Seq")
+	if seq == nil {
+		seq = prop.CreateElement("rdf:Seq")
+	}
+
+	li := seq.CreateElement("rdf:li")
+	li.CreateAttr("rdf:parseType", "Resource")
+	li.CreateElement("stEvt:action").SetText(event.Action)
+	li.CreateElement("stEvt:when").SetText(event.Timestamp.Format(time.RFC3339))
+	if event.SoftwareAgent != "" {
+		li.CreateElement("stEvt:softwareAgent").SetText(event.SoftwareAgent)
+	}
+	if event.Rating != nil {
+		li.CreateElement("stEvt:parameters").SetText(fmt.Sprintf("rating %d", *event.Rating))
+		li.CreateElement("ImageFunnel:Rating").SetText(strconv.Itoa(*event.Rating))
+	}
+	if event.SessionID != "" {
+		li.CreateElement("ImageFunnel:SessionID").SetText(event.SessionID)
+	}
+}
+
+// #endregion
+
+// readXMPFile 读取 sidecar 内容及其修订版本
+//
+// 文件不存在时返回的修订版本为空字符串
+func readXMPFile(path string) (_ []byte, revision string, _ error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	// 先通过文件句柄获取状态，保证修订版本不会比读到的内容更新
+	info, err := f.Stat()
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, revisionOf(info), nil
+}
+
+func (r *Repository) Revision(imagePath string) (string, error) {
+	info, err := os.Stat(imagePath + ".xmp")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return revisionOf(info), nil
+}
+
+// revisionOf 根据修改时间和大小生成修订版本
+func revisionOf(info os.FileInfo) string {
+	return fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size())
+}
+
 func writeXMPFile(doc *etree.Document, path string) error {
 	doc.Indent(2)
 	output, err := doc.WriteToString()
@@ -172,6 +172,24 @@ func TestWrite_InvalidFileBackup(t *testing.T) {
 	assert.Equal(t, 3, readData.Rating())
 }
 
+func TestRead_UnparseableRevision(t *testing.T) {
+	repo := NewRepository()
+	tempFile := filepath.Join(t.TempDir(), "test.jpg")
+	require.NoError(t, os.WriteFile(tempFile+".xmp", []byte("<x:xmpmeta><rdf:RDF>"), 0644))
+
+	_, err := repo.Read(tempFile)
+	require.Error(t, err)
+
+	// 损坏的 sidecar 也有修订版本，写入时以此检测冲突
+	revision, err2 := repo.Revision(tempFile)
+	require.NoError(t, err2)
+	require.NotEmpty(t, revision)
+	assert.Equal(t, revision, metadata.UnparseableRevision(err))
+
+	err = repo.Write(tempFile, metadata.NewXMPData(3, "keep", time.Now()), metadata.WithExpectedRevision(revision))
+	require.NoError(t, err)
+}
+
 func TestWrite_XMPTKAttribute(t *testing.T) {
 	repo := NewRepository()
 	tempFile := filepath.Join(os.TempDir(), "test-xmptk.jpg")
@@ -250,3 +268,283 @@ func TestRead_CustomNamespacePrefix_ChildElement(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 2, data.Rating())
 }
+
+func TestWriteAndRead_Note(t *testing.T) {
+	repo := NewRepository()
+	tempFile := filepath.Join(os.TempDir(), "test-note.jpg")
+	xmpPath := tempFile + ".xmp"
+	defer os.Remove(xmpPath)
+
+	err := repo.Write(tempFile, metadata.NewXMPData(3, "keep", time.Now(), metadata.WithNote("great composition")))
+	require.NoError(t, err)
+
+	readData, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.True(t, readData.HasNote())
+	assert.Equal(t, "great composition", readData.Note())
+
+	content, err := os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `<rdf:li xml:lang="x-default">great composition</rdf:li>`)
+
+	// 不带备注写入时应保留已有备注
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "keep", time.Now()))
+	require.NoError(t, err)
+
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, 5, readData.Rating())
+	assert.Equal(t, "great composition", readData.Note())
+
+	// 空备注清除描述
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "keep", time.Now(), metadata.WithNote("")))
+	require.NoError(t, err)
+
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.False(t, readData.HasNote())
+
+	content, err = os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "description")
+}
+
+func TestWriteAndRead_Orientation(t *testing.T) {
+	repo := NewRepository()
+	tempFile := filepath.Join(t.TempDir(), "test-orientation.jpg")
+	xmpPath := tempFile + ".xmp"
+
+	err := repo.Write(tempFile, metadata.NewXMPData(3, "KEEP", time.Now(), metadata.WithOrientation(6)), metadata.WithHistoryAction("ORIENT"))
+	require.NoError(t, err)
+
+	readData, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, 6, readData.Orientation())
+	require.Len(t, readData.History(), 1)
+	assert.Equal(t, "ORIENT", readData.History()[0].Action)
+
+	content, err := os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), TIFFNamespace)
+
+	// 不带方向写入时应保留已有方向
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "KEEP", time.Now()))
+	require.NoError(t, err)
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, 6, readData.Orientation())
+	assert.Equal(t, "KEEP", readData.History()[1].Action)
+
+	// 方向为 0 时删除
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "KEEP", time.Now(), metadata.WithOrientation(0)))
+	require.NoError(t, err)
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.False(t, readData.HasOrientation())
+
+	content, err = os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "Orientation")
+}
+
+func TestRead_Orientation_ChildElement(t *testing.T) {
+	repo := NewRepository()
+	tempFile := filepath.Join(t.TempDir(), "test.jpg")
+	xmp := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description rdf:about="" xmlns:t="http://ns.adobe.com/tiff/1.0/">
+   <t:Orientation>8</t:Orientation>
+  </rdf:Description>
+ </rdf:RDF>
+</x:xmpmeta>`
+	require.NoError(t, os.WriteFile(tempFile+".xmp", []byte(xmp), 0644))
+
+	readData, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, 8, readData.Orientation())
+
+	err = repo.Write(tempFile, metadata.NewXMPData(0, "", time.Now(), metadata.WithOrientation(0)))
+	require.NoError(t, err)
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, 0, readData.Orientation())
+}
+
+func TestWrite_Note_PreservesOtherLanguages(t *testing.T) {
+	repo := NewRepository()
+	tempFile := filepath.Join(os.TempDir(), "test-note-lang.jpg")
+	xmpPath := tempFile + ".xmp"
+	defer os.Remove(xmpPath)
+
+	initialXMP := `<?xml version="1.0" encoding="UTF-8"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:dc="http://purl.org/dc/elements/1.1/">
+      <dc:description>
+        <rdf:Alt>
+          <rdf:li xml:lang="en-US">A cat on a sofa</rdf:li>
+        </rdf:Alt>
+      </dc:description>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+	err := os.WriteFile(xmpPath, []byte(initialXMP), 0644)
+	require.NoError(t, err)
+
+	// 没有 x-default 时读取第一项
+	readData, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, "A cat on a sofa", readData.Note())
+
+	err = repo.Write(tempFile, metadata.NewXMPData(4, "keep", time.Now(), metadata.WithNote("fix left hand")))
+	require.NoError(t, err)
+
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, "fix left hand", readData.Note())
+
+	content, err := os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `<rdf:li xml:lang="en-US">A cat on a sofa</rdf:li>`)
+	assert.Equal(t, 1, strings.Count(string(content), "<dc:description>"))
+
+	// 清除备注时保留其他语言项，读取时不回退到其他语言项
+	err = repo.Write(tempFile, metadata.NewXMPData(4, "keep", time.Now(), metadata.WithNote("")))
+	require.NoError(t, err)
+
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Empty(t, readData.Note())
+
+	content, err = os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `<rdf:li xml:lang="en-US">A cat on a sofa</rdf:li>`)
+
+	// 再次写入其他字段不会恢复已清除的备注
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "keep", time.Now()))
+	require.NoError(t, err)
+
+	readData, err = repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Empty(t, readData.Note())
+}
+
+func TestWrite_AppendsHistory(t *testing.T) {
+	repo := NewRepository(WithSoftwareAgent("ImageFunnel v1.2.3"))
+	tempFile := filepath.Join(os.TempDir(), "test-history.jpg")
+	xmpPath := tempFile + ".xmp"
+	defer os.Remove(xmpPath)
+
+	first := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	second := first.Add(30 * 24 * time.Hour)
+
+	err := repo.Write(tempFile, metadata.NewXMPData(5, "KEEP", first, metadata.WithSessionID("session-1")))
+	require.NoError(t, err)
+	err = repo.Write(tempFile, metadata.NewXMPData(-1, "REJECT", second))
+	require.NoError(t, err)
+
+	readData, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	require.Len(t, readData.History(), 2)
+
+	event := readData.History()[0]
+	assert.Equal(t, "KEEP", event.Action)
+	require.NotNil(t, event.Rating)
+	assert.Equal(t, 5, *event.Rating)
+	assert.True(t, first.Equal(event.Timestamp))
+	assert.Equal(t, "ImageFunnel v1.2.3", event.SoftwareAgent)
+	assert.Equal(t, "session-1", event.SessionID)
+
+	event = readData.History()[1]
+	assert.Equal(t, "REJECT", event.Action)
+	require.NotNil(t, event.Rating)
+	assert.Equal(t, -1, *event.Rating)
+	assert.True(t, second.Equal(event.Timestamp))
+	assert.Empty(t, event.SessionID)
+
+	content, err := os.ReadFile(xmpPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), XMPMMNamespace)
+	assert.Contains(t, string(content), StEvtNamespace)
+	assert.Equal(t, 1, strings.Count(string(content), "<xmpMM:History>"))
+}
+
+func TestWrite_AppendsHistory_PreservesExistingEvents(t *testing.T) {
+	repo := NewRepository()
+	tempFile := filepath.Join(os.TempDir(), "test-history-existing.jpg")
+	xmpPath := tempFile + ".xmp"
+	defer os.Remove(xmpPath)
+
+	// 其他工具写入的历史，使用属性形式
+	initialXMP := `<?xml version="1.0" encoding="UTF-8"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+        xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/"
+        xmlns:stEvt="http://ns.adobe.com/xap/1.0/sType/ResourceEvent#">
+      <xmpMM:History>
+        <rdf:Seq>
+          <rdf:li stEvt:action="saved" stEvt:when="2025-05-01T10:00:00+08:00" stEvt:softwareAgent="Adobe Photoshop 25.0"/>
+        </rdf:Seq>
+      </xmpMM:History>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+	err := os.WriteFile(xmpPath, []byte(initialXMP), 0644)
+	require.NoError(t, err)
+
+	err = repo.Write(tempFile, metadata.NewXMPData(3, "SHELVE", time.Now()))
+	require.NoError(t, err)
+
+	readData, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	require.Len(t, readData.History(), 2)
+	assert.Equal(t, "saved", readData.History()[0].Action)
+	assert.Equal(t, "Adobe Photoshop 25.0", readData.History()[0].SoftwareAgent)
+	assert.Nil(t, readData.History()[0].Rating)
+	assert.Equal(t, "SHELVE", readData.History()[1].Action)
+	assert.Equal(t, DefaultSoftwareAgent, readData.History()[1].SoftwareAgent)
+}
+
+func TestWrite_ExpectedRevision(t *testing.T) {
+	repo := NewRepository()
+	tempFile := filepath.Join(os.TempDir(), "test-revision.jpg")
+	xmpPath := tempFile + ".xmp"
+	defer os.Remove(xmpPath)
+	defer os.Remove(xmpPath + "~")
+
+	// 期望不存在时可以创建
+	err := repo.Write(tempFile, metadata.NewXMPData(3, "keep", time.Now()), metadata.WithExpectedRevision(""))
+	require.NoError(t, err)
+
+	loaded, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded.Revision())
+
+	// 读取后被外部修改
+	externalXMP := `<?xml version="1.0" encoding="UTF-8"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:xmp="http://ns.adobe.com/xap/1.0/" xmp:Rating="1" xmp:Label="Red"/>
+  </rdf:RDF>
+</x:xmpmeta>`
+	require.NoError(t, os.WriteFile(xmpPath, []byte(externalXMP), 0644))
+	require.NoError(t, os.Chtimes(xmpPath, time.Now(), time.Now().Add(time.Second)))
+
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "keep", time.Now()), metadata.WithExpectedRevision(loaded.Revision()))
+	require.Error(t, err)
+	assert.True(t, metadata.IsXMPConflict(err))
+
+	// 冲突时不应写入
+	readData, err := repo.Read(tempFile)
+	require.NoError(t, err)
+	assert.Equal(t, 1, readData.Rating())
+
+	// 使用最新的修订版本可以写入
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "keep", time.Now()), metadata.WithExpectedRevision(readData.Revision()))
+	require.NoError(t, err)
+
+	// 期望不存在但文件已存在
+	err = repo.Write(tempFile, metadata.NewXMPData(5, "keep", time.Now()), metadata.WithExpectedRevision(""))
+	assert.True(t, metadata.IsXMPConflict(err))
+}
@@ -0,0 +1,28 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+)
+
+// CancelSessionCreation is the resolver for the cancelSessionCreation field.
+func (r *mutationResolver) CancelSessionCreation(ctx context.Context, input CancelSessionCreationInput) (*CancelSessionCreationPayload, error) {
+	err := r.app.CancelSessionCreation(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := r.app.Session(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CancelSessionCreationPayload{
+		Session:          sess,
+		ClientMutationID: input.ClientMutationID,
+	}, nil
+}
@@ -7,6 +7,7 @@ package graphql
 
 import (
 	"context"
+	"main/internal/util"
 
 	"github.com/99designs/gqlgen/graphql"
 )
@@ -19,6 +20,7 @@ func (r *mutationResolver) CommitChanges(ctx context.Context, input CommitChange
 		input.WriteActions.KeepRating,
 		input.WriteActions.ShelveRating,
 		input.WriteActions.RejectRating,
+		util.UnwrapPointer(input.ConflictPolicy),
 	)
 	if err != nil {
 		graphql.AddError(ctx, err)
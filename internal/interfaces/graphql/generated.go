@@ -54,6 +54,11 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	CancelSessionCreationPayload struct {
+		ClientMutationID func(childComplexity int) int
+		Session          func(childComplexity int) int
+	}
+
 	CommitChangesPayload struct {
 		ClientMutationID func(childComplexity int) int
 		Session          func(childComplexity int) int
@@ -83,20 +88,66 @@ type ComplexityRoot struct {
 
 	Image struct {
 		CurrentRating func(childComplexity int) int
+		Duration      func(childComplexity int) int
 		Filename      func(childComplexity int) int
 		Height        func(childComplexity int) int
+		History       func(childComplexity int) int
 		ID            func(childComplexity int) int
+		MediaType     func(childComplexity int) int
 		ModTime       func(childComplexity int) int
+		Note          func(childComplexity int) int
+		Orientation   func(childComplexity int) int
+		Placeholder   func(childComplexity int) int
 		Size          func(childComplexity int) int
-		URL           func(childComplexity int, width *int, quality *int) int
+		Srcset        func(childComplexity int, widths []int, quality *int) int
+		TileSource    func(childComplexity int) int
+		URL           func(childComplexity int, width *int, quality *int, format *enum.Enum[shared.ImageFormatMeta]) int
+		VideoURL      func(childComplexity int) int
 		Width         func(childComplexity int) int
+		XMPError      func(childComplexity int) int
 		XMPExists     func(childComplexity int) int
 	}
 
+	ImageCacheStats struct {
+		Count   func(childComplexity int) int
+		Hits    func(childComplexity int) int
+		MaxSize func(childComplexity int) int
+		Misses  func(childComplexity int) int
+		Size    func(childComplexity int) int
+	}
+
 	ImageFilters struct {
 		Rating func(childComplexity int) int
 	}
 
+	ImageHistoryEvent struct {
+		Action        func(childComplexity int) int
+		Rating        func(childComplexity int) int
+		SessionID     func(childComplexity int) int
+		SoftwareAgent func(childComplexity int) int
+		Timestamp     func(childComplexity int) int
+	}
+
+	ImageSrcset struct {
+		Candidates func(childComplexity int) int
+		Sizes      func(childComplexity int) int
+		Srcset     func(childComplexity int) int
+	}
+
+	ImageSrcsetCandidate struct {
+		URL   func(childComplexity int) int
+		Width func(childComplexity int) int
+	}
+
+	ImageTileSource struct {
+		Height   func(childComplexity int) int
+		MaxLevel func(childComplexity int) int
+		Overlap  func(childComplexity int) int
+		TileSize func(childComplexity int) int
+		URL      func(childComplexity int) int
+		Width    func(childComplexity int) int
+	}
+
 	MarkImagePayload struct {
 		ClientMutationID func(childComplexity int) int
 		Session          func(childComplexity int) int
@@ -108,18 +159,31 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		CommitChanges func(childComplexity int, input CommitChangesInput) int
-		CreateSession func(childComplexity int, input CreateSessionInput) int
-		MarkImage     func(childComplexity int, input MarkImageInput) int
-		Undo          func(childComplexity int, input UndoInput) int
-		UpdateSession func(childComplexity int, input UpdateSessionInput) int
+		CancelSessionCreation func(childComplexity int, input CancelSessionCreationInput) int
+		CommitChanges         func(childComplexity int, input CommitChangesInput) int
+		CreateSession         func(childComplexity int, input CreateSessionInput) int
+		MarkImage             func(childComplexity int, input MarkImageInput) int
+		PurgeImageCache       func(childComplexity int, input PurgeImageCacheInput) int
+		ResolveSidecarIssue   func(childComplexity int, input ResolveSidecarIssueInput) int
+		SetImageNote          func(childComplexity int, input SetImageNoteInput) int
+		SetImageOrientation   func(childComplexity int, input SetImageOrientationInput) int
+		Undo                  func(childComplexity int, input UndoInput) int
+		UpdateSession         func(childComplexity int, input UpdateSessionInput) int
+	}
+
+	PurgeImageCachePayload struct {
+		ClientMutationID func(childComplexity int) int
+		Count            func(childComplexity int) int
+		Stats            func(childComplexity int) int
 	}
 
 	Query struct {
-		Meta          func(childComplexity int) int
-		Node          func(childComplexity int, id scalar.ID) int
-		RootDirectory func(childComplexity int) int
-		Session       func(childComplexity int, id scalar.ID) int
+		ImageCacheStats func(childComplexity int) int
+		Meta            func(childComplexity int) int
+		Node            func(childComplexity int, id scalar.ID) int
+		RootDirectory   func(childComplexity int) int
+		Session         func(childComplexity int, id scalar.ID) int
+		SidecarIssues   func(childComplexity int, directoryID *scalar.ID) int
 	}
 
 	RatingCount struct {
@@ -127,6 +191,11 @@ type ComplexityRoot struct {
 		Rating func(childComplexity int) int
 	}
 
+	ResolveSidecarIssuePayload struct {
+		ClientMutationID func(childComplexity int) int
+		Rating           func(childComplexity int) int
+	}
+
 	Session struct {
 		CanCommit    func(childComplexity int) int
 		CanUndo      func(childComplexity int) int
@@ -138,12 +207,21 @@ type ComplexityRoot struct {
 		Filter       func(childComplexity int) int
 		ID           func(childComplexity int) int
 		KeptImages   func(childComplexity int, limit *int, offset *int) int
+		LoadProgress func(childComplexity int) int
 		NextImages   func(childComplexity int, count *int) int
 		Stats        func(childComplexity int) int
 		TargetKeep   func(childComplexity int) int
 		UpdatedAt    func(childComplexity int) int
 	}
 
+	SessionLoadProgress struct {
+		Error          func(childComplexity int) int
+		FilesSeen      func(childComplexity int) int
+		ImagesAccepted func(childComplexity int) int
+		SessionID      func(childComplexity int) int
+		State          func(childComplexity int) int
+	}
+
 	SessionStats struct {
 		IsCompleted func(childComplexity int) int
 		Kept        func(childComplexity int) int
@@ -153,9 +231,30 @@ type ComplexityRoot struct {
 		Total       func(childComplexity int) int
 	}
 
+	SetImageNotePayload struct {
+		ClientMutationID func(childComplexity int) int
+		Session          func(childComplexity int) int
+	}
+
+	SetImageOrientationPayload struct {
+		ClientMutationID func(childComplexity int) int
+		Session          func(childComplexity int) int
+	}
+
+	SidecarIssue struct {
+		Error             func(childComplexity int) int
+		ID                func(childComplexity int) int
+		ImagePath         func(childComplexity int) int
+		Kind              func(childComplexity int) int
+		ModTime           func(childComplexity int) int
+		Path              func(childComplexity int) int
+		SalvageableRating func(childComplexity int) int
+	}
+
 	Subscription struct {
-		DirectoryChanged func(childComplexity int, filterBy *shared.DirectoryFilters) int
-		SessionUpdated   func(childComplexity int, id scalar.ID) int
+		DirectoryChanged    func(childComplexity int, filterBy *shared.DirectoryFilters) int
+		SessionLoadProgress func(childComplexity int, id scalar.ID) int
+		SessionUpdated      func(childComplexity int, id scalar.ID) int
 	}
 
 	UndoPayload struct {
@@ -183,20 +282,33 @@ type DirectoryStatsResolver interface {
 	RatingCounts(ctx context.Context, obj *shared.DirectoryStatsDTO) ([]*RatingCount, error)
 }
 type ImageResolver interface {
-	URL(ctx context.Context, obj *shared.ImageDTO, width *int, quality *int) (string, error)
+	URL(ctx context.Context, obj *shared.ImageDTO, width *int, quality *int, format *enum.Enum[shared.ImageFormatMeta]) (string, error)
+	Srcset(ctx context.Context, obj *shared.ImageDTO, widths []int, quality *int) (*shared.ImageSrcsetDTO, error)
+	Placeholder(ctx context.Context, obj *shared.ImageDTO) (*string, error)
+
+	TileSource(ctx context.Context, obj *shared.ImageDTO) (*shared.ImageTileSourceDTO, error)
+
+	VideoURL(ctx context.Context, obj *shared.ImageDTO) (*string, error)
 }
 type MutationResolver interface {
 	CreateSession(ctx context.Context, input CreateSessionInput) (*CreateSessionPayload, error)
+	CancelSessionCreation(ctx context.Context, input CancelSessionCreationInput) (*CancelSessionCreationPayload, error)
 	CommitChanges(ctx context.Context, input CommitChangesInput) (*CommitChangesPayload, error)
 	MarkImage(ctx context.Context, input MarkImageInput) (*MarkImagePayload, error)
+	PurgeImageCache(ctx context.Context, input PurgeImageCacheInput) (*PurgeImageCachePayload, error)
+	ResolveSidecarIssue(ctx context.Context, input ResolveSidecarIssueInput) (*ResolveSidecarIssuePayload, error)
+	SetImageNote(ctx context.Context, input SetImageNoteInput) (*SetImageNotePayload, error)
+	SetImageOrientation(ctx context.Context, input SetImageOrientationInput) (*SetImageOrientationPayload, error)
 	Undo(ctx context.Context, input UndoInput) (*UndoPayload, error)
 	UpdateSession(ctx context.Context, input UpdateSessionInput) (*UpdateSessionPayload, error)
 }
 type QueryResolver interface {
 	Node(ctx context.Context, id scalar.ID) (Node, error)
+	ImageCacheStats(ctx context.Context) (*shared.ImageCacheStatsDTO, error)
 	Meta(ctx context.Context) (*Meta, error)
 	RootDirectory(ctx context.Context) (*shared.DirectoryDTO, error)
 	Session(ctx context.Context, id scalar.ID) (*shared.SessionDTO, error)
+	SidecarIssues(ctx context.Context, directoryID *scalar.ID) ([]*shared.SidecarIssueDTO, error)
 }
 type SessionResolver interface {
 	Directory(ctx context.Context, obj *shared.SessionDTO) (*shared.DirectoryDTO, error)
@@ -210,6 +322,7 @@ type SessionResolver interface {
 type SubscriptionResolver interface {
 	SessionUpdated(ctx context.Context, id scalar.ID) (<-chan *shared.SessionDTO, error)
 	DirectoryChanged(ctx context.Context, filterBy *shared.DirectoryFilters) (<-chan *shared.DirectoryDTO, error)
+	SessionLoadProgress(ctx context.Context, id scalar.ID) (<-chan *shared.SessionLoadProgressDTO, error)
 }
 
 type executableSchema struct {
@@ -231,6 +344,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CancelSessionCreationPayload.clientMutationId":
+		if e.complexity.CancelSessionCreationPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.CancelSessionCreationPayload.ClientMutationID(childComplexity), true
+	case "CancelSessionCreationPayload.session":
+		if e.complexity.CancelSessionCreationPayload.Session == nil {
+			break
+		}
+
+		return e.complexity.CancelSessionCreationPayload.Session(childComplexity), true
+
 	case "CommitChangesPayload.clientMutationId":
 		if e.complexity.CommitChangesPayload.ClientMutationID == nil {
 			break
@@ -331,6 +457,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Image.CurrentRating(childComplexity), true
+	case "Image.duration":
+		if e.complexity.Image.Duration == nil {
+			break
+		}
+
+		return e.complexity.Image.Duration(childComplexity), true
 	case "Image.filename":
 		if e.complexity.Image.Filename == nil {
 			break
@@ -343,24 +475,71 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Image.Height(childComplexity), true
+	case "Image.history":
+		if e.complexity.Image.History == nil {
+			break
+		}
+
+		return e.complexity.Image.History(childComplexity), true
 	case "Image.id":
 		if e.complexity.Image.ID == nil {
 			break
 		}
 
 		return e.complexity.Image.ID(childComplexity), true
+	case "Image.mediaType":
+		if e.complexity.Image.MediaType == nil {
+			break
+		}
+
+		return e.complexity.Image.MediaType(childComplexity), true
 	case "Image.modTime":
 		if e.complexity.Image.ModTime == nil {
 			break
 		}
 
 		return e.complexity.Image.ModTime(childComplexity), true
+	case "Image.note":
+		if e.complexity.Image.Note == nil {
+			break
+		}
+
+		return e.complexity.Image.Note(childComplexity), true
+	case "Image.orientation":
+		if e.complexity.Image.Orientation == nil {
+			break
+		}
+
+		return e.complexity.Image.Orientation(childComplexity), true
+	case "Image.placeholder":
+		if e.complexity.Image.Placeholder == nil {
+			break
+		}
+
+		return e.complexity.Image.Placeholder(childComplexity), true
 	case "Image.size":
 		if e.complexity.Image.Size == nil {
 			break
 		}
 
 		return e.complexity.Image.Size(childComplexity), true
+	case "Image.srcset":
+		if e.complexity.Image.Srcset == nil {
+			break
+		}
+
+		args, err := ec.field_Image_srcset_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Image.Srcset(childComplexity, args["widths"].([]int), args["quality"].(*int)), true
+	case "Image.tileSource":
+		if e.complexity.Image.TileSource == nil {
+			break
+		}
+
+		return e.complexity.Image.TileSource(childComplexity), true
 	case "Image.url":
 		if e.complexity.Image.URL == nil {
 			break
@@ -371,13 +550,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Image.URL(childComplexity, args["width"].(*int), args["quality"].(*int)), true
+		return e.complexity.Image.URL(childComplexity, args["width"].(*int), args["quality"].(*int), args["format"].(*enum.Enum[shared.ImageFormatMeta])), true
+	case "Image.videoUrl":
+		if e.complexity.Image.VideoURL == nil {
+			break
+		}
+
+		return e.complexity.Image.VideoURL(childComplexity), true
 	case "Image.width":
 		if e.complexity.Image.Width == nil {
 			break
 		}
 
 		return e.complexity.Image.Width(childComplexity), true
+	case "Image.xmpError":
+		if e.complexity.Image.XMPError == nil {
+			break
+		}
+
+		return e.complexity.Image.XMPError(childComplexity), true
 	case "Image.xmpExists":
 		if e.complexity.Image.XMPExists == nil {
 			break
@@ -385,6 +576,37 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Image.XMPExists(childComplexity), true
 
+	case "ImageCacheStats.count":
+		if e.complexity.ImageCacheStats.Count == nil {
+			break
+		}
+
+		return e.complexity.ImageCacheStats.Count(childComplexity), true
+	case "ImageCacheStats.hits":
+		if e.complexity.ImageCacheStats.Hits == nil {
+			break
+		}
+
+		return e.complexity.ImageCacheStats.Hits(childComplexity), true
+	case "ImageCacheStats.maxSize":
+		if e.complexity.ImageCacheStats.MaxSize == nil {
+			break
+		}
+
+		return e.complexity.ImageCacheStats.MaxSize(childComplexity), true
+	case "ImageCacheStats.misses":
+		if e.complexity.ImageCacheStats.Misses == nil {
+			break
+		}
+
+		return e.complexity.ImageCacheStats.Misses(childComplexity), true
+	case "ImageCacheStats.size":
+		if e.complexity.ImageCacheStats.Size == nil {
+			break
+		}
+
+		return e.complexity.ImageCacheStats.Size(childComplexity), true
+
 	case "ImageFilters.rating":
 		if e.complexity.ImageFilters.Rating == nil {
 			break
@@ -392,6 +614,106 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ImageFilters.Rating(childComplexity), true
 
+	case "ImageHistoryEvent.action":
+		if e.complexity.ImageHistoryEvent.Action == nil {
+			break
+		}
+
+		return e.complexity.ImageHistoryEvent.Action(childComplexity), true
+	case "ImageHistoryEvent.rating":
+		if e.complexity.ImageHistoryEvent.Rating == nil {
+			break
+		}
+
+		return e.complexity.ImageHistoryEvent.Rating(childComplexity), true
+	case "ImageHistoryEvent.sessionId":
+		if e.complexity.ImageHistoryEvent.SessionID == nil {
+			break
+		}
+
+		return e.complexity.ImageHistoryEvent.SessionID(childComplexity), true
+	case "ImageHistoryEvent.softwareAgent":
+		if e.complexity.ImageHistoryEvent.SoftwareAgent == nil {
+			break
+		}
+
+		return e.complexity.ImageHistoryEvent.SoftwareAgent(childComplexity), true
+	case "ImageHistoryEvent.timestamp":
+		if e.complexity.ImageHistoryEvent.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.ImageHistoryEvent.Timestamp(childComplexity), true
+
+	case "ImageSrcset.candidates":
+		if e.complexity.ImageSrcset.Candidates == nil {
+			break
+		}
+
+		return e.complexity.ImageSrcset.Candidates(childComplexity), true
+	case "ImageSrcset.sizes":
+		if e.complexity.ImageSrcset.Sizes == nil {
+			break
+		}
+
+		return e.complexity.ImageSrcset.Sizes(childComplexity), true
+	case "ImageSrcset.srcset":
+		if e.complexity.ImageSrcset.Srcset == nil {
+			break
+		}
+
+		return e.complexity.ImageSrcset.Srcset(childComplexity), true
+
+	case "ImageSrcsetCandidate.url":
+		if e.complexity.ImageSrcsetCandidate.URL == nil {
+			break
+		}
+
+		return e.complexity.ImageSrcsetCandidate.URL(childComplexity), true
+	case "ImageSrcsetCandidate.width":
+		if e.complexity.ImageSrcsetCandidate.Width == nil {
+			break
+		}
+
+		return e.complexity.ImageSrcsetCandidate.Width(childComplexity), true
+
+	case "ImageTileSource.height":
+		if e.complexity.ImageTileSource.Height == nil {
+			break
+		}
+
+		return e.complexity.ImageTileSource.Height(childComplexity), true
+	case "ImageTileSource.maxLevel":
+		if e.complexity.ImageTileSource.MaxLevel == nil {
+			break
+		}
+
+		return e.complexity.ImageTileSource.MaxLevel(childComplexity), true
+	case "ImageTileSource.overlap":
+		if e.complexity.ImageTileSource.Overlap == nil {
+			break
+		}
+
+		return e.complexity.ImageTileSource.Overlap(childComplexity), true
+	case "ImageTileSource.tileSize":
+		if e.complexity.ImageTileSource.TileSize == nil {
+			break
+		}
+
+		return e.complexity.ImageTileSource.TileSize(childComplexity), true
+	case "ImageTileSource.url":
+		if e.complexity.ImageTileSource.URL == nil {
+			break
+		}
+
+		return e.complexity.ImageTileSource.URL(childComplexity), true
+	case "ImageTileSource.width":
+		if e.complexity.ImageTileSource.Width == nil {
+			break
+		}
+
+		return e.complexity.ImageTileSource.Width(childComplexity), true
+
 	case "MarkImagePayload.clientMutationId":
 		if e.complexity.MarkImagePayload.ClientMutationID == nil {
 			break
@@ -418,6 +740,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Meta.Version(childComplexity), true
 
+	case "Mutation.cancelSessionCreation":
+		if e.complexity.Mutation.CancelSessionCreation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelSessionCreation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelSessionCreation(childComplexity, args["input"].(CancelSessionCreationInput)), true
 	case "Mutation.commitChanges":
 		if e.complexity.Mutation.CommitChanges == nil {
 			break
@@ -451,6 +784,50 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.MarkImage(childComplexity, args["input"].(MarkImageInput)), true
+	case "Mutation.purgeImageCache":
+		if e.complexity.Mutation.PurgeImageCache == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_purgeImageCache_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PurgeImageCache(childComplexity, args["input"].(PurgeImageCacheInput)), true
+	case "Mutation.resolveSidecarIssue":
+		if e.complexity.Mutation.ResolveSidecarIssue == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resolveSidecarIssue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResolveSidecarIssue(childComplexity, args["input"].(ResolveSidecarIssueInput)), true
+	case "Mutation.setImageNote":
+		if e.complexity.Mutation.SetImageNote == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setImageNote_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetImageNote(childComplexity, args["input"].(SetImageNoteInput)), true
+	case "Mutation.setImageOrientation":
+		if e.complexity.Mutation.SetImageOrientation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setImageOrientation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetImageOrientation(childComplexity, args["input"].(SetImageOrientationInput)), true
 	case "Mutation.undo":
 		if e.complexity.Mutation.Undo == nil {
 			break
@@ -474,6 +851,31 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.UpdateSession(childComplexity, args["input"].(UpdateSessionInput)), true
 
+	case "PurgeImageCachePayload.clientMutationId":
+		if e.complexity.PurgeImageCachePayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.PurgeImageCachePayload.ClientMutationID(childComplexity), true
+	case "PurgeImageCachePayload.count":
+		if e.complexity.PurgeImageCachePayload.Count == nil {
+			break
+		}
+
+		return e.complexity.PurgeImageCachePayload.Count(childComplexity), true
+	case "PurgeImageCachePayload.stats":
+		if e.complexity.PurgeImageCachePayload.Stats == nil {
+			break
+		}
+
+		return e.complexity.PurgeImageCachePayload.Stats(childComplexity), true
+
+	case "Query.imageCacheStats":
+		if e.complexity.Query.ImageCacheStats == nil {
+			break
+		}
+
+		return e.complexity.Query.ImageCacheStats(childComplexity), true
 	case "Query.meta":
 		if e.complexity.Query.Meta == nil {
 			break
@@ -508,6 +910,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Session(childComplexity, args["id"].(scalar.ID)), true
+	case "Query.sidecarIssues":
+		if e.complexity.Query.SidecarIssues == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sidecarIssues_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SidecarIssues(childComplexity, args["directoryId"].(*scalar.ID)), true
 
 	case "RatingCount.count":
 		if e.complexity.RatingCount.Count == nil {
@@ -522,6 +935,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.RatingCount.Rating(childComplexity), true
 
+	case "ResolveSidecarIssuePayload.clientMutationId":
+		if e.complexity.ResolveSidecarIssuePayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.ResolveSidecarIssuePayload.ClientMutationID(childComplexity), true
+	case "ResolveSidecarIssuePayload.rating":
+		if e.complexity.ResolveSidecarIssuePayload.Rating == nil {
+			break
+		}
+
+		return e.complexity.ResolveSidecarIssuePayload.Rating(childComplexity), true
+
 	case "Session.canCommit":
 		if e.complexity.Session.CanCommit == nil {
 			break
@@ -587,6 +1013,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Session.KeptImages(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+	case "Session.loadProgress":
+		if e.complexity.Session.LoadProgress == nil {
+			break
+		}
+
+		return e.complexity.Session.LoadProgress(childComplexity), true
 	case "Session.nextImages":
 		if e.complexity.Session.NextImages == nil {
 			break
@@ -617,6 +1049,37 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Session.UpdatedAt(childComplexity), true
 
+	case "SessionLoadProgress.error":
+		if e.complexity.SessionLoadProgress.Error == nil {
+			break
+		}
+
+		return e.complexity.SessionLoadProgress.Error(childComplexity), true
+	case "SessionLoadProgress.filesSeen":
+		if e.complexity.SessionLoadProgress.FilesSeen == nil {
+			break
+		}
+
+		return e.complexity.SessionLoadProgress.FilesSeen(childComplexity), true
+	case "SessionLoadProgress.imagesAccepted":
+		if e.complexity.SessionLoadProgress.ImagesAccepted == nil {
+			break
+		}
+
+		return e.complexity.SessionLoadProgress.ImagesAccepted(childComplexity), true
+	case "SessionLoadProgress.sessionId":
+		if e.complexity.SessionLoadProgress.SessionID == nil {
+			break
+		}
+
+		return e.complexity.SessionLoadProgress.SessionID(childComplexity), true
+	case "SessionLoadProgress.state":
+		if e.complexity.SessionLoadProgress.State == nil {
+			break
+		}
+
+		return e.complexity.SessionLoadProgress.State(childComplexity), true
+
 	case "SessionStats.isCompleted":
 		if e.complexity.SessionStats.IsCompleted == nil {
 			break
@@ -654,63 +1117,143 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SessionStats.Total(childComplexity), true
 
-	case "Subscription.directoryChanged":
-		if e.complexity.Subscription.DirectoryChanged == nil {
+	case "SetImageNotePayload.clientMutationId":
+		if e.complexity.SetImageNotePayload.ClientMutationID == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_directoryChanged_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.SetImageNotePayload.ClientMutationID(childComplexity), true
+	case "SetImageNotePayload.session":
+		if e.complexity.SetImageNotePayload.Session == nil {
+			break
 		}
 
-		return e.complexity.Subscription.DirectoryChanged(childComplexity, args["filterBy"].(*shared.DirectoryFilters)), true
-	case "Subscription.sessionUpdated":
-		if e.complexity.Subscription.SessionUpdated == nil {
+		return e.complexity.SetImageNotePayload.Session(childComplexity), true
+
+	case "SetImageOrientationPayload.clientMutationId":
+		if e.complexity.SetImageOrientationPayload.ClientMutationID == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_sessionUpdated_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.SetImageOrientationPayload.ClientMutationID(childComplexity), true
+	case "SetImageOrientationPayload.session":
+		if e.complexity.SetImageOrientationPayload.Session == nil {
+			break
 		}
 
-		return e.complexity.Subscription.SessionUpdated(childComplexity, args["id"].(scalar.ID)), true
+		return e.complexity.SetImageOrientationPayload.Session(childComplexity), true
 
-	case "UndoPayload.clientMutationId":
-		if e.complexity.UndoPayload.ClientMutationID == nil {
+	case "SidecarIssue.error":
+		if e.complexity.SidecarIssue.Error == nil {
 			break
 		}
 
-		return e.complexity.UndoPayload.ClientMutationID(childComplexity), true
-	case "UndoPayload.session":
-		if e.complexity.UndoPayload.Session == nil {
+		return e.complexity.SidecarIssue.Error(childComplexity), true
+	case "SidecarIssue.id":
+		if e.complexity.SidecarIssue.ID == nil {
 			break
 		}
 
-		return e.complexity.UndoPayload.Session(childComplexity), true
-
-	case "UpdateSessionPayload.clientMutationId":
-		if e.complexity.UpdateSessionPayload.ClientMutationID == nil {
+		return e.complexity.SidecarIssue.ID(childComplexity), true
+	case "SidecarIssue.imagePath":
+		if e.complexity.SidecarIssue.ImagePath == nil {
 			break
 		}
 
-		return e.complexity.UpdateSessionPayload.ClientMutationID(childComplexity), true
-	case "UpdateSessionPayload.session":
-		if e.complexity.UpdateSessionPayload.Session == nil {
+		return e.complexity.SidecarIssue.ImagePath(childComplexity), true
+	case "SidecarIssue.kind":
+		if e.complexity.SidecarIssue.Kind == nil {
 			break
 		}
 
-		return e.complexity.UpdateSessionPayload.Session(childComplexity), true
-
-	case "WriteActions.keepRating":
-		if e.complexity.WriteActions.KeepRating == nil {
+		return e.complexity.SidecarIssue.Kind(childComplexity), true
+	case "SidecarIssue.modTime":
+		if e.complexity.SidecarIssue.ModTime == nil {
 			break
 		}
 
-		return e.complexity.WriteActions.KeepRating(childComplexity), true
-	case "WriteActions.rejectRating":
-		if e.complexity.WriteActions.RejectRating == nil {
+		return e.complexity.SidecarIssue.ModTime(childComplexity), true
+	case "SidecarIssue.path":
+		if e.complexity.SidecarIssue.Path == nil {
+			break
+		}
+
+		return e.complexity.SidecarIssue.Path(childComplexity), true
+	case "SidecarIssue.salvageableRating":
+		if e.complexity.SidecarIssue.SalvageableRating == nil {
+			break
+		}
+
+		return e.complexity.SidecarIssue.SalvageableRating(childComplexity), true
+
+	case "Subscription.directoryChanged":
+		if e.complexity.Subscription.DirectoryChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_directoryChanged_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.DirectoryChanged(childComplexity, args["filterBy"].(*shared.DirectoryFilters)), true
+	case "Subscription.sessionLoadProgress":
+		if e.complexity.Subscription.SessionLoadProgress == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_sessionLoadProgress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SessionLoadProgress(childComplexity, args["id"].(scalar.ID)), true
+	case "Subscription.sessionUpdated":
+		if e.complexity.Subscription.SessionUpdated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_sessionUpdated_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SessionUpdated(childComplexity, args["id"].(scalar.ID)), true
+
+	case "UndoPayload.clientMutationId":
+		if e.complexity.UndoPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.UndoPayload.ClientMutationID(childComplexity), true
+	case "UndoPayload.session":
+		if e.complexity.UndoPayload.Session == nil {
+			break
+		}
+
+		return e.complexity.UndoPayload.Session(childComplexity), true
+
+	case "UpdateSessionPayload.clientMutationId":
+		if e.complexity.UpdateSessionPayload.ClientMutationID == nil {
+			break
+		}
+
+		return e.complexity.UpdateSessionPayload.ClientMutationID(childComplexity), true
+	case "UpdateSessionPayload.session":
+		if e.complexity.UpdateSessionPayload.Session == nil {
+			break
+		}
+
+		return e.complexity.UpdateSessionPayload.Session(childComplexity), true
+
+	case "WriteActions.keepRating":
+		if e.complexity.WriteActions.KeepRating == nil {
+			break
+		}
+
+		return e.complexity.WriteActions.KeepRating(childComplexity), true
+	case "WriteActions.rejectRating":
+		if e.complexity.WriteActions.RejectRating == nil {
 			break
 		}
 
@@ -730,11 +1273,16 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCancelSessionCreationInput,
 		ec.unmarshalInputCommitChangesInput,
 		ec.unmarshalInputCreateSessionInput,
 		ec.unmarshalInputDirectoryFilters,
 		ec.unmarshalInputImageFiltersInput,
 		ec.unmarshalInputMarkImageInput,
+		ec.unmarshalInputPurgeImageCacheInput,
+		ec.unmarshalInputResolveSidecarIssueInput,
+		ec.unmarshalInputSetImageNoteInput,
+		ec.unmarshalInputSetImageOrientationInput,
 		ec.unmarshalInputUndoInput,
 		ec.unmarshalInputUpdateSessionInput,
 		ec.unmarshalInputWriteActionsInput,
@@ -890,12 +1438,91 @@ directive @goField(
   id: ID!
   filename: String!
   size: Int!
-  url(width: Int, quality: Int): URI!
+  """
+  预览图地址，未指定 format 时根据请求的 Accept 头协商输出格式
+
+  处理优先级通过请求头 X-Image-Priority 指定，数值越小越优先：0 为正在查看的图片（默认），
+  后续图片使用与当前图片的距离，后台预热使用 100。
+  优先级不影响地址，同一张图片的不同优先级请求可以共享浏览器缓存
+  """
+  url(width: Int, quality: Int, format: ImageFormat): URI!
+  """
+  响应式图片地址，由浏览器按像素比和布局宽度选择，未指定 widths 时使用标准宽度阶梯。
+  不放大图片：不小于原图宽度的宽度使用原图尺寸的地址（与不指定 width 的 url 相同）。
+  相同参数总是生成相同的地址，可以命中浏览器缓存
+  """
+  srcset(widths: [Int!], quality: Int): ImageSrcset!
+  """
+  Base64 编码的 ThumbHash（https://github.com/evanw/thumbhash），用于预览图加载前显示模糊的占位图。
+  首次请求时在后台生成并保存到图片索引，生成完成前、生成失败或未启用索引时为 null
+  """
+  placeholder: String
   modTime: Time!
+  """
+  按 EXIF/XMP 方向旋转后的显示宽度
+  """
   width: Int!
+  """
+  按 EXIF/XMP 方向旋转后的显示高度
+  """
   height: Int!
+  """
+  生效的 EXIF 方向（1-8），通过 setImageOrientation 设置的方向优先于原图中的方向
+  """
+  orientation: Int!
   currentRating: Int
   xmpExists: Boolean!
+  """
+  备注，来自 XMP 的 dc:description 或会话中尚未提交的修改
+  """
+  note: String
+  """
+  XMP 编辑历史，按时间先后排列
+  """
+  history: [ImageHistoryEvent!]!
+  """
+  XMP sidecar 读取失败时的错误，可通过 sidecarIssues 查询修复
+  """
+  xmpError: String
+  """
+  Deep Zoom（DZI）瓦片源，用于按需加载原始分辨率的局部区域
+  """
+  tileSource: ImageTileSource!
+  """
+  媒体类型，视频的 url、tileSource 基于封面帧生成
+  """
+  mediaType: MediaType!
+  """
+  视频时长，图片为 null
+  """
+  duration: Duration
+  """
+  视频原文件地址，支持 Range 请求，图片为 null
+  """
+  videoUrl: URI
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/types/image_cache_stats.graphql", Input: `type ImageCacheStats @goModel(model: "main/internal/shared.ImageCacheStatsDTO") {
+  """
+  命中次数
+  """
+  hits: Int!
+  """
+  未命中次数
+  """
+  misses: Int!
+  """
+  缓存项数量
+  """
+  count: Int!
+  """
+  总大小（字节）
+  """
+  size: Int!
+  """
+  大小上限（字节），0 表示不限制
+  """
+  maxSize: Int!
 }
 `, BuiltIn: false},
 	{Name: "../../../graph/types/image_filters.graphql", Input: `type ImageFilters
@@ -907,6 +1534,60 @@ input ImageFiltersInput
   @goModel(model: "main/internal/shared.ImageFilters") {
   rating: [Int!]!
 }
+`, BuiltIn: false},
+	{Name: "../../../graph/types/image_history_event.graphql", Input: `"""
+XMP 编辑历史（xmpMM:History）中的一条记录，可能由其他工具写入
+"""
+type ImageHistoryEvent @goModel(model: "main/internal/shared.ImageHistoryEventDTO") {
+  action: String!
+  rating: Int
+  timestamp: Time
+  softwareAgent: String
+  sessionId: ID
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/types/image_srcset.graphql", Input: `"""
+响应式图片地址，可以直接用作 img 元素的 srcset 和 sizes 属性
+"""
+type ImageSrcset @goModel(model: "main/internal/shared.ImageSrcsetDTO") {
+  """
+  按宽度升序排列的 ` + "`" + `地址 宽度w` + "`" + ` 列表，以逗号分隔。原图尺寸未知时只包含原尺寸的地址
+  """
+  srcset: String!
+  """
+  图片最多占满视口宽度且不超过原图宽度
+  """
+  sizes: String!
+  candidates: [ImageSrcsetCandidate!]!
+}
+
+type ImageSrcsetCandidate @goModel(model: "main/internal/shared.ImageSrcsetCandidateDTO") {
+  url: URI!
+  """
+  预览图的显示宽度，原图尺寸未知时为 0
+  """
+  width: Int!
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/types/image_tile_source.graphql", Input: `"""
+Deep Zoom（DZI）瓦片源
+
+层级 0 为 1x1，maxLevel 为原始尺寸，每降低一级尺寸减半（向上取整）
+"""
+type ImageTileSource @goModel(model: "main/internal/shared.ImageTileSourceDTO") {
+  """
+  瓦片地址，追加 ` + "`" + `l` + "`" + `（层级）、` + "`" + `x` + "`" + `（列）、` + "`" + `y` + "`" + `（行）参数获取具体瓦片
+  """
+  url: URI!
+  width: Int!
+  height: Int!
+  tileSize: Int!
+  """
+  瓦片每侧与相邻瓦片重叠的像素数
+  """
+  overlap: Int!
+  maxLevel: Int!
+}
 `, BuiltIn: false},
 	{Name: "../../../graph/types/meta.graphql", Input: `type Meta {
   rootPath: String!
@@ -935,9 +1616,25 @@ input ImageFiltersInput
   currentIndex: Int!
   currentSize: Int!
   currentImage: Image
+  loadProgress: SessionLoadProgress!
   nextImages(count: Int): [Image!]!
   keptImages(limit: Int, offset: Int): [Image!]!
 }
+`, BuiltIn: false},
+	{Name: "../../../graph/types/session_load_progress.graphql", Input: `type SessionLoadProgress
+  @goModel(model: "main/internal/shared.SessionLoadProgressDTO") {
+  sessionId: ID!
+  state: SessionLoadState!
+  """
+  已扫描的图片数量
+  """
+  filesSeen: Int!
+  """
+  其中符合过滤条件的数量
+  """
+  imagesAccepted: Int!
+  error: String
+}
 `, BuiltIn: false},
 	{Name: "../../../graph/types/session_stats.graphql", Input: `type SessionStats @goModel(model: "main/internal/shared.StatsDTO") {
   total: Int!
@@ -947,6 +1644,31 @@ input ImageFiltersInput
   remaining: Int!
   isCompleted: Boolean!
 }
+`, BuiltIn: false},
+	{Name: "../../../graph/types/sidecar_issue.graphql", Input: `"""
+需要修复的 sidecar 相关文件
+"""
+type SidecarIssue @goModel(model: "main/internal/shared.SidecarIssueDTO") {
+  id: ID!
+  kind: SidecarIssueKind!
+  """
+  问题文件相对根目录的路径
+  """
+  path: String!
+  """
+  所属图片相对根目录的路径，图片本身可能已不存在
+  """
+  imagePath: String!
+  modTime: Time!
+  """
+  解析错误
+  """
+  error: String
+  """
+  宽松解析得到的评分
+  """
+  salvageableRating: Int
+}
 `, BuiltIn: false},
 	{Name: "../../../graph/types/write_actions.graphql", Input: `type WriteActions @goModel(model: "main/internal/shared.WriteActions") {
   keepRating: Int!
@@ -959,6 +1681,92 @@ input ImageFiltersInput
   SHELVE
   REJECT
 }
+`, BuiltIn: false},
+	{Name: "../../../graph/enums/image_format.graphql", Input: `enum ImageFormat @goModel(model: "main/internal/shared.ImageFormat") {
+  AVIF
+  WEBP
+  JPEG
+  """
+  仅用于输出带透明度的预览
+  """
+  PNG
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/enums/media_type.graphql", Input: `enum MediaType @goModel(model: "main/internal/shared.MediaType") {
+  IMAGE
+  VIDEO
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/enums/session_load_state.graphql", Input: `enum SessionLoadState @goModel(model: "main/internal/shared.SessionLoadState") {
+  """
+  正在扫描目录，扫描到的图片陆续加入队列
+  """
+  LOADING
+  READY
+  """
+  扫描被取消，会话只包含取消前扫描到的图片
+  """
+  CANCELLED
+  """
+  扫描失败，会话只包含失败前扫描到的图片
+  """
+  FAILED
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/enums/sidecar_issue_kind.graphql", Input: `enum SidecarIssueKind @goModel(model: "main/internal/shared.SidecarIssueKind") {
+  """
+  sidecar 无法解析
+  """
+  UNPARSEABLE
+  """
+  原子写入中断后遗留的 ` + "`" + `~` + "`" + ` 备份
+  """
+  BACKUP
+  """
+  写入时发现 sidecar 损坏而另存的 ` + "`" + `.broken` + "`" + ` 文件
+  """
+  BROKEN_BACKUP
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/enums/sidecar_recovery_action.graphql", Input: `enum SidecarRecoveryAction @goModel(model: "main/internal/shared.SidecarRecoveryAction") {
+  """
+  用备份替换 sidecar，不适用于 BROKEN_BACKUP
+  """
+  RESTORE
+  """
+  宽松地从问题文件中提取评分并写入 sidecar
+  """
+  SALVAGE
+  """
+  删除问题文件
+  """
+  DISCARD
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/enums/xmp_conflict_policy.graphql", Input: `"""
+XMP sidecar 在会话加载图片后被外部修改（如 Lightroom 或其他 ImageFunnel 实例）时的处理方式
+"""
+enum XMPConflictPolicy @goModel(model: "main/internal/shared.XMPConflictPolicy") {
+  """
+  跳过并报告 XMP_CONFLICT 错误
+  """
+  SKIP
+  """
+  忽略外部修改直接写入
+  """
+  OVERWRITE
+  """
+  合并外部修改，同一字段被双方改为不同值时报告 XMP_CONFLICT 错误
+  """
+  MERGE
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/queries/image_cache_stats.graphql", Input: `extend type Query {
+  """
+  预览图缓存统计
+  """
+  imageCacheStats: ImageCacheStats!
+}
 `, BuiltIn: false},
 	{Name: "../../../graph/queries/meta.graphql", Input: `extend type Query {
   meta: Meta!
@@ -975,6 +1783,13 @@ input ImageFiltersInput
 	{Name: "../../../graph/queries/session.graphql", Input: `extend type Query {
   session(id: ID!): Session
 }
+`, BuiltIn: false},
+	{Name: "../../../graph/queries/sidecar_issues.graphql", Input: `extend type Query {
+  """
+  递归列出目录下需要修复的 sidecar 文件，默认为根目录
+  """
+  sidecarIssues(directoryId: ID): [SidecarIssue!]!
+}
 `, BuiltIn: false},
 	{Name: "../../../graph/subscriptions/directory_changed.graphql", Input: `extend type Subscription {
   directoryChanged(filterBy: DirectoryFilters): Directory!
@@ -987,14 +1802,44 @@ input DirectoryFilters
   """
   id: [ID!]
 }
+`, BuiltIn: false},
+	{Name: "../../../graph/subscriptions/session_load_progress.graphql", Input: `extend type Subscription {
+  """
+  会话加载进度，首先返回当前进度；会话不在加载中时随即完成，否则加载结束后完成
+  """
+  sessionLoadProgress(id: ID!): SessionLoadProgress!
+}
 `, BuiltIn: false},
 	{Name: "../../../graph/subscriptions/session_updated.graphql", Input: `type Subscription {
   sessionUpdated(id: ID!): Session!
 }
+`, BuiltIn: false},
+	{Name: "../../../graph/mutations/cancel_session_creation.graphql", Input: `input CancelSessionCreationInput {
+  sessionId: ID!
+  clientMutationId: String
+}
+
+type CancelSessionCreationPayload {
+  session: Session!
+  clientMutationId: String
+}
+
+extend type Mutation {
+  """
+  取消会话的目录扫描，已扫描到的图片保留在会话中
+  """
+  cancelSessionCreation(
+    input: CancelSessionCreationInput!
+  ): CancelSessionCreationPayload!
+}
 `, BuiltIn: false},
 	{Name: "../../../graph/mutations/commit_changes.graphql", Input: `input CommitChangesInput {
   sessionId: ID!
   writeActions: WriteActionsInput!
+  """
+  默认为 SKIP
+  """
+  conflictPolicy: XMPConflictPolicy
   clientMutationId: String
 }
 
@@ -1047,41 +1892,147 @@ extend type Mutation {
   markImage(input: MarkImageInput!): MarkImagePayload!
 }
 `, BuiltIn: false},
-	{Name: "../../../graph/mutations/undo.graphql", Input: `input UndoInput {
-  sessionId: ID!
+	{Name: "../../../graph/mutations/purge_image_cache.graphql", Input: `input PurgeImageCacheInput {
+  """
+  源图片相对于根目录的路径，为空时清除全部缓存
+  """
+  path: String
   clientMutationId: String
 }
 
-type UndoPayload {
-  session: Session
+type PurgeImageCachePayload {
+  """
+  删除的缓存项数量
+  """
+  count: Int!
+  stats: ImageCacheStats!
   clientMutationId: String
 }
 
 extend type Mutation {
-  undo(input: UndoInput!): UndoPayload
+  """
+  清除预览图缓存
+  """
+  purgeImageCache(input: PurgeImageCacheInput!): PurgeImageCachePayload!
 }
 `, BuiltIn: false},
-	{Name: "../../../graph/mutations/update_session.graphql", Input: `input UpdateSessionInput {
-  sessionId: ID!
-  targetKeep: Int
-  filter: ImageFiltersInput
+	{Name: "../../../graph/mutations/resolve_sidecar_issue.graphql", Input: `input ResolveSidecarIssueInput {
+  id: ID!
+  action: SidecarRecoveryAction!
   clientMutationId: String
 }
 
-type UpdateSessionPayload {
-  session: Session!
+type ResolveSidecarIssuePayload {
+  """
+  使用 SALVAGE 时提取到的评分
+  """
+  rating: Int
   clientMutationId: String
 }
 
 extend type Mutation {
-  updateSession(input: UpdateSessionInput!): UpdateSessionPayload!
-}`, BuiltIn: false},
+  """
+  修复损坏的 sidecar
+  """
+  resolveSidecarIssue(input: ResolveSidecarIssueInput!): ResolveSidecarIssuePayload!
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/mutations/set_image_note.graphql", Input: `input SetImageNoteInput {
+  sessionId: ID!
+  imageId: ID!
+  """
+  空字符串表示清除备注
+  """
+  note: String!
+  clientMutationId: String
 }
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
-
-// endregion ************************** generated!.gotpl **************************
 
-// region    ***************************** args.gotpl *****************************
+type SetImageNotePayload {
+  session: Session!
+  clientMutationId: String
+}
+
+extend type Mutation {
+  """
+  设置图片备注，提交时写入 XMP 的 dc:description
+  """
+  setImageNote(input: SetImageNoteInput!): SetImageNotePayload!
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/mutations/set_image_orientation.graphql", Input: `input SetImageOrientationInput {
+  sessionId: ID!
+  imageId: ID!
+  """
+  EXIF 方向（1-8），null 表示清除，恢复使用原图中的方向
+  """
+  orientation: Int
+  clientMutationId: String
+}
+
+type SetImageOrientationPayload {
+  session: Session!
+  clientMutationId: String
+}
+
+extend type Mutation {
+  """
+  设置图片方向，立即写入 XMP 的 tiff:Orientation，不修改原图。
+  预览和瓦片按该方向旋转，可以通过 undo 撤销
+  """
+  setImageOrientation(input: SetImageOrientationInput!): SetImageOrientationPayload!
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/mutations/undo.graphql", Input: `input UndoInput {
+  sessionId: ID!
+  clientMutationId: String
+}
+
+type UndoPayload {
+  session: Session
+  clientMutationId: String
+}
+
+extend type Mutation {
+  undo(input: UndoInput!): UndoPayload
+}
+`, BuiltIn: false},
+	{Name: "../../../graph/mutations/update_session.graphql", Input: `input UpdateSessionInput {
+  sessionId: ID!
+  targetKeep: Int
+  filter: ImageFiltersInput
+  clientMutationId: String
+}
+
+type UpdateSessionPayload {
+  session: Session!
+  clientMutationId: String
+}
+
+extend type Mutation {
+  updateSession(input: UpdateSessionInput!): UpdateSessionPayload!
+}`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Image_srcset_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "widths", ec.unmarshalOInt2ᚕintᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["widths"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "quality", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["quality"] = arg1
+	return args, nil
+}
 
 func (ec *executionContext) field_Image_url_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
@@ -1096,6 +2047,22 @@ func (ec *executionContext) field_Image_url_args(ctx context.Context, rawArgs ma
 		return nil, err
 	}
 	args["quality"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "format", ec.unmarshalOImageFormat2ᚖmainᚋinternalᚋenumᚐEnum)
+	if err != nil {
+		return nil, err
+	}
+	args["format"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_cancelSessionCreation_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCancelSessionCreationInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐCancelSessionCreationInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
 	return args, nil
 }
 
@@ -1132,6 +2099,50 @@ func (ec *executionContext) field_Mutation_markImage_args(ctx context.Context, r
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_purgeImageCache_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNPurgeImageCacheInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐPurgeImageCacheInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_resolveSidecarIssue_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNResolveSidecarIssueInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐResolveSidecarIssueInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setImageNote_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSetImageNoteInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageNoteInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setImageOrientation_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSetImageOrientationInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageOrientationInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_undo_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1187,6 +2198,17 @@ func (ec *executionContext) field_Query_session_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_sidecarIssues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "directoryId", ec.unmarshalOID2ᚖmainᚋinternalᚋscalarᚐID)
+	if err != nil {
+		return nil, err
+	}
+	args["directoryId"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Session_keptImages_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1225,6 +2247,17 @@ func (ec *executionContext) field_Subscription_directoryChanged_args(ctx context
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_sessionLoadProgress_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2mainᚋinternalᚋscalarᚐID)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Subscription_sessionUpdated_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1288,6 +2321,96 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _CancelSessionCreationPayload_session(ctx context.Context, field graphql.CollectedField, obj *CancelSessionCreationPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CancelSessionCreationPayload_session,
+		func(ctx context.Context) (any, error) {
+			return obj.Session, nil
+		},
+		nil,
+		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CancelSessionCreationPayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CancelSessionCreationPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CancelSessionCreationPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *CancelSessionCreationPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CancelSessionCreationPayload_clientMutationId,
+		func(ctx context.Context) (any, error) {
+			return obj.ClientMutationID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CancelSessionCreationPayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CancelSessionCreationPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CommitChangesPayload_written(ctx context.Context, field graphql.CollectedField, obj *CommitChangesPayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -1365,6 +2488,8 @@ func (ec *executionContext) fieldContext_CommitChangesPayload_session(_ context.
 				return ec.fieldContext_Session_currentSize(ctx, field)
 			case "currentImage":
 				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
 			case "nextImages":
 				return ec.fieldContext_Session_nextImages(ctx, field)
 			case "keptImages":
@@ -1453,6 +2578,8 @@ func (ec *executionContext) fieldContext_CreateSessionPayload_session(_ context.
 				return ec.fieldContext_Session_currentSize(ctx, field)
 			case "currentImage":
 				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
 			case "nextImages":
 				return ec.fieldContext_Session_nextImages(ctx, field)
 			case "keptImages":
@@ -1781,16 +2908,36 @@ func (ec *executionContext) fieldContext_DirectoryStats_latestImage(_ context.Co
 				return ec.fieldContext_Image_size(ctx, field)
 			case "url":
 				return ec.fieldContext_Image_url(ctx, field)
+			case "srcset":
+				return ec.fieldContext_Image_srcset(ctx, field)
+			case "placeholder":
+				return ec.fieldContext_Image_placeholder(ctx, field)
 			case "modTime":
 				return ec.fieldContext_Image_modTime(ctx, field)
 			case "width":
 				return ec.fieldContext_Image_width(ctx, field)
 			case "height":
 				return ec.fieldContext_Image_height(ctx, field)
+			case "orientation":
+				return ec.fieldContext_Image_orientation(ctx, field)
 			case "currentRating":
 				return ec.fieldContext_Image_currentRating(ctx, field)
 			case "xmpExists":
 				return ec.fieldContext_Image_xmpExists(ctx, field)
+			case "note":
+				return ec.fieldContext_Image_note(ctx, field)
+			case "history":
+				return ec.fieldContext_Image_history(ctx, field)
+			case "xmpError":
+				return ec.fieldContext_Image_xmpError(ctx, field)
+			case "tileSource":
+				return ec.fieldContext_Image_tileSource(ctx, field)
+			case "mediaType":
+				return ec.fieldContext_Image_mediaType(ctx, field)
+			case "duration":
+				return ec.fieldContext_Image_duration(ctx, field)
+			case "videoUrl":
+				return ec.fieldContext_Image_videoUrl(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
 		},
@@ -1928,7 +3075,7 @@ func (ec *executionContext) _Image_url(ctx context.Context, field graphql.Collec
 		ec.fieldContext_Image_url,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Image().URL(ctx, obj, fc.Args["width"].(*int), fc.Args["quality"].(*int))
+			return ec.resolvers.Image().URL(ctx, obj, fc.Args["width"].(*int), fc.Args["quality"].(*int), fc.Args["format"].(*enum.Enum[shared.ImageFormatMeta]))
 		},
 		nil,
 		ec.marshalNURI2string,
@@ -1961,110 +3108,130 @@ func (ec *executionContext) fieldContext_Image_url(ctx context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_modTime(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_srcset(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Image_modTime,
+		ec.fieldContext_Image_srcset,
 		func(ctx context.Context) (any, error) {
-			return obj.ModTime, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Image().Srcset(ctx, obj, fc.Args["widths"].([]int), fc.Args["quality"].(*int))
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNImageSrcset2ᚖmainᚋinternalᚋsharedᚐImageSrcsetDTO,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Image_modTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_srcset(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "srcset":
+				return ec.fieldContext_ImageSrcset_srcset(ctx, field)
+			case "sizes":
+				return ec.fieldContext_ImageSrcset_sizes(ctx, field)
+			case "candidates":
+				return ec.fieldContext_ImageSrcset_candidates(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageSrcset", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Image_srcset_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_width(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_placeholder(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Image_width,
+		ec.fieldContext_Image_placeholder,
 		func(ctx context.Context) (any, error) {
-			return obj.Width, nil
+			return ec.resolvers.Image().Placeholder(ctx, obj)
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Image_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_placeholder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Image",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_height(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_modTime(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Image_height,
+		ec.fieldContext_Image_modTime,
 		func(ctx context.Context) (any, error) {
-			return obj.Height, nil
+			return obj.ModTime, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Image_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_modTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_currentRating(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_width(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Image_currentRating,
+		ec.fieldContext_Image_width,
 		func(ctx context.Context) (any, error) {
-			return obj.CurrentRating, nil
+			return obj.Width, nil
 		},
 		nil,
-		ec.marshalOInt2int,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Image_currentRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Image",
 		Field:      field,
@@ -2077,54 +3244,54 @@ func (ec *executionContext) fieldContext_Image_currentRating(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Image_xmpExists(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_height(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Image_xmpExists,
+		ec.fieldContext_Image_height,
 		func(ctx context.Context) (any, error) {
-			return obj.XMPExists, nil
+			return obj.Height, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Image_xmpExists(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ImageFilters_rating(ctx context.Context, field graphql.CollectedField, obj *shared.ImageFilters) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_orientation(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ImageFilters_rating,
+		ec.fieldContext_Image_orientation,
 		func(ctx context.Context) (any, error) {
-			return obj.Rating, nil
+			return obj.Orientation, nil
 		},
 		nil,
-		ec.marshalOInt2ᚕintᚄ,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ImageFilters_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_orientation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ImageFilters",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2135,113 +3302,83 @@ func (ec *executionContext) fieldContext_ImageFilters_rating(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _MarkImagePayload_session(ctx context.Context, field graphql.CollectedField, obj *MarkImagePayload) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_currentRating(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MarkImagePayload_session,
+		ec.fieldContext_Image_currentRating,
 		func(ctx context.Context) (any, error) {
-			return obj.Session, nil
+			return obj.CurrentRating, nil
 		},
 		nil,
-		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
-		true,
+		ec.marshalOInt2int,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_MarkImagePayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_currentRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MarkImagePayload",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Session_id(ctx, field)
-			case "directory":
-				return ec.fieldContext_Session_directory(ctx, field)
-			case "filter":
-				return ec.fieldContext_Session_filter(ctx, field)
-			case "targetKeep":
-				return ec.fieldContext_Session_targetKeep(ctx, field)
-			case "stats":
-				return ec.fieldContext_Session_stats(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Session_updatedAt(ctx, field)
-			case "canCommit":
-				return ec.fieldContext_Session_canCommit(ctx, field)
-			case "canUndo":
-				return ec.fieldContext_Session_canUndo(ctx, field)
-			case "currentIndex":
-				return ec.fieldContext_Session_currentIndex(ctx, field)
-			case "currentSize":
-				return ec.fieldContext_Session_currentSize(ctx, field)
-			case "currentImage":
-				return ec.fieldContext_Session_currentImage(ctx, field)
-			case "nextImages":
-				return ec.fieldContext_Session_nextImages(ctx, field)
-			case "keptImages":
-				return ec.fieldContext_Session_keptImages(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MarkImagePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *MarkImagePayload) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_xmpExists(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_MarkImagePayload_clientMutationId,
+		ec.fieldContext_Image_xmpExists,
 		func(ctx context.Context) (any, error) {
-			return obj.ClientMutationID, nil
+			return obj.XMPExists, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_MarkImagePayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_xmpExists(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MarkImagePayload",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Meta_rootPath(ctx context.Context, field graphql.CollectedField, obj *Meta) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_note(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Meta_rootPath,
+		ec.fieldContext_Image_note,
 		func(ctx context.Context) (any, error) {
-			return obj.RootPath, nil
+			return obj.Note, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Meta_rootPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Meta",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2252,618 +3389,428 @@ func (ec *executionContext) fieldContext_Meta_rootPath(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Meta_version(ctx context.Context, field graphql.CollectedField, obj *Meta) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_history(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Meta_version,
+		ec.fieldContext_Image_history,
 		func(ctx context.Context) (any, error) {
-			return obj.Version, nil
+			return obj.History, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNImageHistoryEvent2ᚕᚖmainᚋinternalᚋsharedᚐImageHistoryEventDTOᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Meta_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_history(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Meta",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "action":
+				return ec.fieldContext_ImageHistoryEvent_action(ctx, field)
+			case "rating":
+				return ec.fieldContext_ImageHistoryEvent_rating(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_ImageHistoryEvent_timestamp(ctx, field)
+			case "softwareAgent":
+				return ec.fieldContext_ImageHistoryEvent_softwareAgent(ctx, field)
+			case "sessionId":
+				return ec.fieldContext_ImageHistoryEvent_sessionId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageHistoryEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_xmpError(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createSession,
+		ec.fieldContext_Image_xmpError,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateSession(ctx, fc.Args["input"].(CreateSessionInput))
+			return obj.XMPError, nil
 		},
 		nil,
-		ec.marshalNCreateSessionPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐCreateSessionPayload,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_xmpError(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "session":
-				return ec.fieldContext_CreateSessionPayload_session(ctx, field)
-			case "clientMutationId":
-				return ec.fieldContext_CreateSessionPayload_clientMutationId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CreateSessionPayload", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_commitChanges(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_tileSource(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_commitChanges,
+		ec.fieldContext_Image_tileSource,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CommitChanges(ctx, fc.Args["input"].(CommitChangesInput))
+			return ec.resolvers.Image().TileSource(ctx, obj)
 		},
 		nil,
-		ec.marshalNCommitChangesPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐCommitChangesPayload,
+		ec.marshalNImageTileSource2ᚖmainᚋinternalᚋsharedᚐImageTileSourceDTO,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_commitChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_tileSource(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "written":
-				return ec.fieldContext_CommitChangesPayload_written(ctx, field)
-			case "session":
-				return ec.fieldContext_CommitChangesPayload_session(ctx, field)
-			case "clientMutationId":
-				return ec.fieldContext_CommitChangesPayload_clientMutationId(ctx, field)
+			case "url":
+				return ec.fieldContext_ImageTileSource_url(ctx, field)
+			case "width":
+				return ec.fieldContext_ImageTileSource_width(ctx, field)
+			case "height":
+				return ec.fieldContext_ImageTileSource_height(ctx, field)
+			case "tileSize":
+				return ec.fieldContext_ImageTileSource_tileSize(ctx, field)
+			case "overlap":
+				return ec.fieldContext_ImageTileSource_overlap(ctx, field)
+			case "maxLevel":
+				return ec.fieldContext_ImageTileSource_maxLevel(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CommitChangesPayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ImageTileSource", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_commitChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_markImage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_mediaType(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_markImage,
+		ec.fieldContext_Image_mediaType,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().MarkImage(ctx, fc.Args["input"].(MarkImageInput))
+			return obj.MediaType, nil
 		},
 		nil,
-		ec.marshalNMarkImagePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐMarkImagePayload,
+		ec.marshalNMediaType2mainᚋinternalᚋenumᚐEnum,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_markImage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_mediaType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "session":
-				return ec.fieldContext_MarkImagePayload_session(ctx, field)
-			case "clientMutationId":
-				return ec.fieldContext_MarkImagePayload_clientMutationId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MarkImagePayload", field.Name)
+			return nil, errors.New("field of type MediaType does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_markImage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_undo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_duration(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_undo,
+		ec.fieldContext_Image_duration,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().Undo(ctx, fc.Args["input"].(UndoInput))
+			return obj.Duration, nil
 		},
 		nil,
-		ec.marshalOUndoPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐUndoPayload,
+		ec.marshalODuration2ᚖmainᚋinternalᚋscalarᚐDuration,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_undo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Image",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "session":
-				return ec.fieldContext_UndoPayload_session(ctx, field)
-			case "clientMutationId":
-				return ec.fieldContext_UndoPayload_clientMutationId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UndoPayload", field.Name)
+			return nil, errors.New("field of type Duration does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_undo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Image_videoUrl(ctx context.Context, field graphql.CollectedField, obj *shared.ImageDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateSession,
+		ec.fieldContext_Image_videoUrl,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateSession(ctx, fc.Args["input"].(UpdateSessionInput))
+			return ec.resolvers.Image().VideoURL(ctx, obj)
 		},
 		nil,
-		ec.marshalNUpdateSessionPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐUpdateSessionPayload,
-		true,
+		ec.marshalOURI2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Image_videoUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Image",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "session":
-				return ec.fieldContext_UpdateSessionPayload_session(ctx, field)
-			case "clientMutationId":
-				return ec.fieldContext_UpdateSessionPayload_clientMutationId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UpdateSessionPayload", field.Name)
+			return nil, errors.New("field of type URI does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_node(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageCacheStats_hits(ctx context.Context, field graphql.CollectedField, obj *shared.ImageCacheStatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_node,
+		ec.fieldContext_ImageCacheStats_hits,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Node(ctx, fc.Args["id"].(scalar.ID))
+			return obj.Hits, nil
 		},
 		nil,
-		ec.marshalONode2mainᚋinternalᚋinterfacesᚋgraphqlᚐNode,
+		ec.marshalNInt2int64,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageCacheStats_hits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ImageCacheStats",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("FieldContext.Child cannot be called on type INTERFACE")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_node_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_meta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageCacheStats_misses(ctx context.Context, field graphql.CollectedField, obj *shared.ImageCacheStatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_meta,
+		ec.fieldContext_ImageCacheStats_misses,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Meta(ctx)
+			return obj.Misses, nil
 		},
 		nil,
-		ec.marshalNMeta2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐMeta,
+		ec.marshalNInt2int64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_meta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageCacheStats_misses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ImageCacheStats",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "rootPath":
-				return ec.fieldContext_Meta_rootPath(ctx, field)
-			case "version":
-				return ec.fieldContext_Meta_version(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Meta", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_rootDirectory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageCacheStats_count(ctx context.Context, field graphql.CollectedField, obj *shared.ImageCacheStatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_rootDirectory,
+		ec.fieldContext_ImageCacheStats_count,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().RootDirectory(ctx)
+			return obj.Count, nil
 		},
 		nil,
-		ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_rootDirectory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageCacheStats_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ImageCacheStats",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Directory_id(ctx, field)
-			case "parentId":
-				return ec.fieldContext_Directory_parentId(ctx, field)
-			case "path":
-				return ec.fieldContext_Directory_path(ctx, field)
-			case "root":
-				return ec.fieldContext_Directory_root(ctx, field)
-			case "stats":
-				return ec.fieldContext_Directory_stats(ctx, field)
-			case "directories":
-				return ec.fieldContext_Directory_directories(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Directory", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_session(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageCacheStats_size(ctx context.Context, field graphql.CollectedField, obj *shared.ImageCacheStatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_session,
+		ec.fieldContext_ImageCacheStats_size,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Session(ctx, fc.Args["id"].(scalar.ID))
+			return obj.Size, nil
 		},
 		nil,
-		ec.marshalOSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		ec.marshalNInt2int64,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_session(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageCacheStats_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ImageCacheStats",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Session_id(ctx, field)
-			case "directory":
-				return ec.fieldContext_Session_directory(ctx, field)
-			case "filter":
-				return ec.fieldContext_Session_filter(ctx, field)
-			case "targetKeep":
-				return ec.fieldContext_Session_targetKeep(ctx, field)
-			case "stats":
-				return ec.fieldContext_Session_stats(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Session_updatedAt(ctx, field)
-			case "canCommit":
-				return ec.fieldContext_Session_canCommit(ctx, field)
-			case "canUndo":
-				return ec.fieldContext_Session_canUndo(ctx, field)
-			case "currentIndex":
-				return ec.fieldContext_Session_currentIndex(ctx, field)
-			case "currentSize":
-				return ec.fieldContext_Session_currentSize(ctx, field)
-			case "currentImage":
-				return ec.fieldContext_Session_currentImage(ctx, field)
-			case "nextImages":
-				return ec.fieldContext_Session_nextImages(ctx, field)
-			case "keptImages":
-				return ec.fieldContext_Session_keptImages(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_session_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageCacheStats_maxSize(ctx context.Context, field graphql.CollectedField, obj *shared.ImageCacheStatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext_ImageCacheStats_maxSize,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
+			return obj.MaxSize, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNInt2int64,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageCacheStats_maxSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ImageCacheStats",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageFilters_rating(ctx context.Context, field graphql.CollectedField, obj *shared.ImageFilters) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___schema,
+		ec.fieldContext_ImageFilters_rating,
 		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
+			return obj.Rating, nil
 		},
 		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		ec.marshalOInt2ᚕintᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageFilters_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ImageFilters",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RatingCount_rating(ctx context.Context, field graphql.CollectedField, obj *RatingCount) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageHistoryEvent_action(ctx context.Context, field graphql.CollectedField, obj *shared.ImageHistoryEventDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RatingCount_rating,
+		ec.fieldContext_ImageHistoryEvent_action,
 		func(ctx context.Context) (any, error) {
-			return obj.Rating, nil
+			return obj.Action, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RatingCount_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageHistoryEvent_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RatingCount",
+		Object:     "ImageHistoryEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RatingCount_count(ctx context.Context, field graphql.CollectedField, obj *RatingCount) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageHistoryEvent_rating(ctx context.Context, field graphql.CollectedField, obj *shared.ImageHistoryEventDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RatingCount_count,
+		ec.fieldContext_ImageHistoryEvent_rating,
 		func(ctx context.Context) (any, error) {
-			return obj.Count, nil
+			return obj.Rating, nil
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalOInt2ᚖint,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RatingCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageHistoryEvent_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RatingCount",
+		Object:     "ImageHistoryEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2874,307 +3821,310 @@ func (ec *executionContext) fieldContext_RatingCount_count(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_id(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageHistoryEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *shared.ImageHistoryEventDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_id,
+		ec.fieldContext_ImageHistoryEvent_timestamp,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Timestamp, nil
 		},
 		nil,
-		ec.marshalNID2mainᚋinternalᚋscalarᚐID,
-		true,
+		ec.marshalOTime2timeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageHistoryEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageHistoryEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_directory(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageHistoryEvent_softwareAgent(ctx context.Context, field graphql.CollectedField, obj *shared.ImageHistoryEventDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_directory,
+		ec.fieldContext_ImageHistoryEvent_softwareAgent,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Session().Directory(ctx, obj)
+			return obj.SoftwareAgent, nil
 		},
 		nil,
-		ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO,
+		ec.marshalOString2string,
 		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ImageHistoryEvent_softwareAgent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImageHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ImageHistoryEvent_sessionId(ctx context.Context, field graphql.CollectedField, obj *shared.ImageHistoryEventDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ImageHistoryEvent_sessionId,
+		func(ctx context.Context) (any, error) {
+			return obj.SessionID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖmainᚋinternalᚋscalarᚐID,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_directory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageHistoryEvent_sessionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageHistoryEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Directory_id(ctx, field)
-			case "parentId":
-				return ec.fieldContext_Directory_parentId(ctx, field)
-			case "path":
-				return ec.fieldContext_Directory_path(ctx, field)
-			case "root":
-				return ec.fieldContext_Directory_root(ctx, field)
-			case "stats":
-				return ec.fieldContext_Directory_stats(ctx, field)
-			case "directories":
-				return ec.fieldContext_Directory_directories(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Directory", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_filter(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageSrcset_srcset(ctx context.Context, field graphql.CollectedField, obj *shared.ImageSrcsetDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_filter,
+		ec.fieldContext_ImageSrcset_srcset,
 		func(ctx context.Context) (any, error) {
-			return obj.Filter, nil
+			return obj.Srcset, nil
 		},
 		nil,
-		ec.marshalNImageFilters2ᚖmainᚋinternalᚋsharedᚐImageFilters,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_filter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageSrcset_srcset(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageSrcset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "rating":
-				return ec.fieldContext_ImageFilters_rating(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ImageFilters", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_targetKeep(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageSrcset_sizes(ctx context.Context, field graphql.CollectedField, obj *shared.ImageSrcsetDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_targetKeep,
+		ec.fieldContext_ImageSrcset_sizes,
 		func(ctx context.Context) (any, error) {
-			return obj.TargetKeep, nil
+			return obj.Sizes, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_targetKeep(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageSrcset_sizes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageSrcset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_stats(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageSrcset_candidates(ctx context.Context, field graphql.CollectedField, obj *shared.ImageSrcsetDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_stats,
+		ec.fieldContext_ImageSrcset_candidates,
 		func(ctx context.Context) (any, error) {
-			return obj.Stats, nil
+			return obj.Candidates, nil
 		},
 		nil,
-		ec.marshalNSessionStats2ᚖmainᚋinternalᚋsharedᚐStatsDTO,
+		ec.marshalNImageSrcsetCandidate2ᚕᚖmainᚋinternalᚋsharedᚐImageSrcsetCandidateDTOᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageSrcset_candidates(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageSrcset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total":
-				return ec.fieldContext_SessionStats_total(ctx, field)
-			case "kept":
-				return ec.fieldContext_SessionStats_kept(ctx, field)
-			case "shelved":
-				return ec.fieldContext_SessionStats_shelved(ctx, field)
-			case "rejected":
-				return ec.fieldContext_SessionStats_rejected(ctx, field)
-			case "remaining":
-				return ec.fieldContext_SessionStats_remaining(ctx, field)
-			case "isCompleted":
-				return ec.fieldContext_SessionStats_isCompleted(ctx, field)
+			case "url":
+				return ec.fieldContext_ImageSrcsetCandidate_url(ctx, field)
+			case "width":
+				return ec.fieldContext_ImageSrcsetCandidate_width(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SessionStats", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ImageSrcsetCandidate", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_createdAt(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageSrcsetCandidate_url(ctx context.Context, field graphql.CollectedField, obj *shared.ImageSrcsetCandidateDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_createdAt,
+		ec.fieldContext_ImageSrcsetCandidate_url,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Session().CreatedAt(ctx, obj)
+			return obj.URL, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNURI2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageSrcsetCandidate_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageSrcsetCandidate",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type URI does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_updatedAt(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageSrcsetCandidate_width(ctx context.Context, field graphql.CollectedField, obj *shared.ImageSrcsetCandidateDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_updatedAt,
+		ec.fieldContext_ImageSrcsetCandidate_width,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Session().UpdatedAt(ctx, obj)
+			return obj.Width, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageSrcsetCandidate_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageSrcsetCandidate",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_canCommit(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageTileSource_url(ctx context.Context, field graphql.CollectedField, obj *shared.ImageTileSourceDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_canCommit,
+		ec.fieldContext_ImageTileSource_url,
 		func(ctx context.Context) (any, error) {
-			return obj.CanCommit, nil
+			return obj.URL, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNURI2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_canCommit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageTileSource_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageTileSource",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type URI does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_canUndo(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageTileSource_width(ctx context.Context, field graphql.CollectedField, obj *shared.ImageTileSourceDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_canUndo,
+		ec.fieldContext_ImageTileSource_width,
 		func(ctx context.Context) (any, error) {
-			return obj.CanUndo, nil
+			return obj.Width, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_canUndo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageTileSource_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageTileSource",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_currentIndex(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageTileSource_height(ctx context.Context, field graphql.CollectedField, obj *shared.ImageTileSourceDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_currentIndex,
+		ec.fieldContext_ImageTileSource_height,
 		func(ctx context.Context) (any, error) {
-			return obj.CurrentIndex, nil
+			return obj.Height, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -3183,9 +4133,9 @@ func (ec *executionContext) _Session_currentIndex(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_currentIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageTileSource_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageTileSource",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3196,14 +4146,14 @@ func (ec *executionContext) fieldContext_Session_currentIndex(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_currentSize(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageTileSource_tileSize(ctx context.Context, field graphql.CollectedField, obj *shared.ImageTileSourceDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_currentSize,
+		ec.fieldContext_ImageTileSource_tileSize,
 		func(ctx context.Context) (any, error) {
-			return obj.CurrentSize, nil
+			return obj.TileSize, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -3212,9 +4162,9 @@ func (ec *executionContext) _Session_currentSize(ctx context.Context, field grap
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_currentSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageTileSource_tileSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageTileSource",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3225,406 +4175,386 @@ func (ec *executionContext) fieldContext_Session_currentSize(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_currentImage(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageTileSource_overlap(ctx context.Context, field graphql.CollectedField, obj *shared.ImageTileSourceDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_currentImage,
+		ec.fieldContext_ImageTileSource_overlap,
 		func(ctx context.Context) (any, error) {
-			return obj.CurrentImage, nil
+			return obj.Overlap, nil
 		},
 		nil,
-		ec.marshalOImage2ᚖmainᚋinternalᚋsharedᚐImageDTO,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_currentImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageTileSource_overlap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageTileSource",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Image_id(ctx, field)
-			case "filename":
-				return ec.fieldContext_Image_filename(ctx, field)
-			case "size":
-				return ec.fieldContext_Image_size(ctx, field)
-			case "url":
-				return ec.fieldContext_Image_url(ctx, field)
-			case "modTime":
-				return ec.fieldContext_Image_modTime(ctx, field)
-			case "width":
-				return ec.fieldContext_Image_width(ctx, field)
-			case "height":
-				return ec.fieldContext_Image_height(ctx, field)
-			case "currentRating":
-				return ec.fieldContext_Image_currentRating(ctx, field)
-			case "xmpExists":
-				return ec.fieldContext_Image_xmpExists(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_nextImages(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _ImageTileSource_maxLevel(ctx context.Context, field graphql.CollectedField, obj *shared.ImageTileSourceDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_nextImages,
+		ec.fieldContext_ImageTileSource_maxLevel,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Session().NextImages(ctx, obj, fc.Args["count"].(*int))
+			return obj.MaxLevel, nil
 		},
 		nil,
-		ec.marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImageDTOᚄ,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_nextImages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ImageTileSource_maxLevel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "ImageTileSource",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Image_id(ctx, field)
-			case "filename":
-				return ec.fieldContext_Image_filename(ctx, field)
-			case "size":
-				return ec.fieldContext_Image_size(ctx, field)
-			case "url":
-				return ec.fieldContext_Image_url(ctx, field)
-			case "modTime":
-				return ec.fieldContext_Image_modTime(ctx, field)
-			case "width":
-				return ec.fieldContext_Image_width(ctx, field)
-			case "height":
-				return ec.fieldContext_Image_height(ctx, field)
-			case "currentRating":
-				return ec.fieldContext_Image_currentRating(ctx, field)
-			case "xmpExists":
-				return ec.fieldContext_Image_xmpExists(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Session_nextImages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_keptImages(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _MarkImagePayload_session(ctx context.Context, field graphql.CollectedField, obj *MarkImagePayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_keptImages,
+		ec.fieldContext_MarkImagePayload_session,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Session().KeptImages(ctx, obj, fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+			return obj.Session, nil
 		},
 		nil,
-		ec.marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImageDTOᚄ,
+		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_keptImages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MarkImagePayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "MarkImagePayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Image_id(ctx, field)
-			case "filename":
-				return ec.fieldContext_Image_filename(ctx, field)
-			case "size":
-				return ec.fieldContext_Image_size(ctx, field)
-			case "url":
-				return ec.fieldContext_Image_url(ctx, field)
-			case "modTime":
-				return ec.fieldContext_Image_modTime(ctx, field)
-			case "width":
-				return ec.fieldContext_Image_width(ctx, field)
-			case "height":
-				return ec.fieldContext_Image_height(ctx, field)
-			case "currentRating":
-				return ec.fieldContext_Image_currentRating(ctx, field)
-			case "xmpExists":
-				return ec.fieldContext_Image_xmpExists(ctx, field)
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Session_keptImages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionStats_total(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _MarkImagePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *MarkImagePayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionStats_total,
+		ec.fieldContext_MarkImagePayload_clientMutationId,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.ClientMutationID, nil
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionStats_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MarkImagePayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionStats",
+		Object:     "MarkImagePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionStats_kept(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Meta_rootPath(ctx context.Context, field graphql.CollectedField, obj *Meta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionStats_kept,
+		ec.fieldContext_Meta_rootPath,
 		func(ctx context.Context) (any, error) {
-			return obj.Kept, nil
+			return obj.RootPath, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionStats_kept(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Meta_rootPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionStats",
+		Object:     "Meta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionStats_shelved(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Meta_version(ctx context.Context, field graphql.CollectedField, obj *Meta) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionStats_shelved,
+		ec.fieldContext_Meta_version,
 		func(ctx context.Context) (any, error) {
-			return obj.Shelved, nil
+			return obj.Version, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionStats_shelved(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Meta_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionStats",
+		Object:     "Meta",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionStats_rejected(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionStats_rejected,
+		ec.fieldContext_Mutation_createSession,
 		func(ctx context.Context) (any, error) {
-			return obj.Rejected, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateSession(ctx, fc.Args["input"].(CreateSessionInput))
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNCreateSessionPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐCreateSessionPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionStats_rejected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionStats",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "session":
+				return ec.fieldContext_CreateSessionPayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_CreateSessionPayload_clientMutationId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreateSessionPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionStats_remaining(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_cancelSessionCreation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionStats_remaining,
+		ec.fieldContext_Mutation_cancelSessionCreation,
 		func(ctx context.Context) (any, error) {
-			return obj.Remaining, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CancelSessionCreation(ctx, fc.Args["input"].(CancelSessionCreationInput))
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNCancelSessionCreationPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐCancelSessionCreationPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionStats_remaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_cancelSessionCreation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionStats",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "session":
+				return ec.fieldContext_CancelSessionCreationPayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_CancelSessionCreationPayload_clientMutationId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CancelSessionCreationPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelSessionCreation_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionStats_isCompleted(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_commitChanges(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionStats_isCompleted,
+		ec.fieldContext_Mutation_commitChanges,
 		func(ctx context.Context) (any, error) {
-			return obj.IsCompleted, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CommitChanges(ctx, fc.Args["input"].(CommitChangesInput))
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNCommitChangesPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐCommitChangesPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionStats_isCompleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_commitChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionStats",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "written":
+				return ec.fieldContext_CommitChangesPayload_written(ctx, field)
+			case "session":
+				return ec.fieldContext_CommitChangesPayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_CommitChangesPayload_clientMutationId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CommitChangesPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_commitChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_sessionUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	return graphql.ResolveFieldStream(
+func (ec *executionContext) _Mutation_markImage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Subscription_sessionUpdated,
+		ec.fieldContext_Mutation_markImage,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Subscription().SessionUpdated(ctx, fc.Args["id"].(scalar.ID))
+			return ec.resolvers.Mutation().MarkImage(ctx, fc.Args["input"].(MarkImageInput))
 		},
 		nil,
-		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		ec.marshalNMarkImagePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐMarkImagePayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Subscription_sessionUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_markImage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Session_id(ctx, field)
-			case "directory":
-				return ec.fieldContext_Session_directory(ctx, field)
-			case "filter":
-				return ec.fieldContext_Session_filter(ctx, field)
-			case "targetKeep":
-				return ec.fieldContext_Session_targetKeep(ctx, field)
-			case "stats":
-				return ec.fieldContext_Session_stats(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Session_updatedAt(ctx, field)
-			case "canCommit":
-				return ec.fieldContext_Session_canCommit(ctx, field)
-			case "canUndo":
-				return ec.fieldContext_Session_canUndo(ctx, field)
-			case "currentIndex":
-				return ec.fieldContext_Session_currentIndex(ctx, field)
-			case "currentSize":
-				return ec.fieldContext_Session_currentSize(ctx, field)
-			case "currentImage":
-				return ec.fieldContext_Session_currentImage(ctx, field)
-			case "nextImages":
-				return ec.fieldContext_Session_nextImages(ctx, field)
-			case "keptImages":
-				return ec.fieldContext_Session_keptImages(ctx, field)
+			case "session":
+				return ec.fieldContext_MarkImagePayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_MarkImagePayload_clientMutationId(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MarkImagePayload", field.Name)
 		},
 	}
 	defer func() {
@@ -3634,52 +4564,46 @@ func (ec *executionContext) fieldContext_Subscription_sessionUpdated(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_sessionUpdated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_markImage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_directoryChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	return graphql.ResolveFieldStream(
+func (ec *executionContext) _Mutation_purgeImageCache(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Subscription_directoryChanged,
+		ec.fieldContext_Mutation_purgeImageCache,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Subscription().DirectoryChanged(ctx, fc.Args["filterBy"].(*shared.DirectoryFilters))
+			return ec.resolvers.Mutation().PurgeImageCache(ctx, fc.Args["input"].(PurgeImageCacheInput))
 		},
 		nil,
-		ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO,
+		ec.marshalNPurgeImageCachePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐPurgeImageCachePayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Subscription_directoryChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_purgeImageCache(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Directory_id(ctx, field)
-			case "parentId":
-				return ec.fieldContext_Directory_parentId(ctx, field)
-			case "path":
-				return ec.fieldContext_Directory_path(ctx, field)
-			case "root":
-				return ec.fieldContext_Directory_root(ctx, field)
+			case "count":
+				return ec.fieldContext_PurgeImageCachePayload_count(ctx, field)
 			case "stats":
-				return ec.fieldContext_Directory_stats(ctx, field)
-			case "directories":
-				return ec.fieldContext_Directory_directories(ctx, field)
+				return ec.fieldContext_PurgeImageCachePayload_stats(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_PurgeImageCachePayload_clientMutationId(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Directory", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PurgeImageCachePayload", field.Name)
 		},
 	}
 	defer func() {
@@ -3689,226 +4613,256 @@ func (ec *executionContext) fieldContext_Subscription_directoryChanged(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_directoryChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_purgeImageCache_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UndoPayload_session(ctx context.Context, field graphql.CollectedField, obj *UndoPayload) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_resolveSidecarIssue(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UndoPayload_session,
+		ec.fieldContext_Mutation_resolveSidecarIssue,
 		func(ctx context.Context) (any, error) {
-			return obj.Session, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ResolveSidecarIssue(ctx, fc.Args["input"].(ResolveSidecarIssueInput))
 		},
 		nil,
-		ec.marshalOSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		ec.marshalNResolveSidecarIssuePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐResolveSidecarIssuePayload,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UndoPayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_resolveSidecarIssue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UndoPayload",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Session_id(ctx, field)
-			case "directory":
-				return ec.fieldContext_Session_directory(ctx, field)
-			case "filter":
-				return ec.fieldContext_Session_filter(ctx, field)
-			case "targetKeep":
-				return ec.fieldContext_Session_targetKeep(ctx, field)
-			case "stats":
-				return ec.fieldContext_Session_stats(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Session_updatedAt(ctx, field)
-			case "canCommit":
-				return ec.fieldContext_Session_canCommit(ctx, field)
-			case "canUndo":
-				return ec.fieldContext_Session_canUndo(ctx, field)
-			case "currentIndex":
-				return ec.fieldContext_Session_currentIndex(ctx, field)
-			case "currentSize":
-				return ec.fieldContext_Session_currentSize(ctx, field)
-			case "currentImage":
-				return ec.fieldContext_Session_currentImage(ctx, field)
-			case "nextImages":
-				return ec.fieldContext_Session_nextImages(ctx, field)
-			case "keptImages":
-				return ec.fieldContext_Session_keptImages(ctx, field)
+			case "rating":
+				return ec.fieldContext_ResolveSidecarIssuePayload_rating(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_ResolveSidecarIssuePayload_clientMutationId(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResolveSidecarIssuePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resolveSidecarIssue_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UndoPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *UndoPayload) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setImageNote(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UndoPayload_clientMutationId,
+		ec.fieldContext_Mutation_setImageNote,
 		func(ctx context.Context) (any, error) {
-			return obj.ClientMutationID, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SetImageNote(ctx, fc.Args["input"].(SetImageNoteInput))
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNSetImageNotePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageNotePayload,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UndoPayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setImageNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UndoPayload",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "session":
+				return ec.fieldContext_SetImageNotePayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_SetImageNotePayload_clientMutationId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SetImageNotePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setImageNote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UpdateSessionPayload_session(ctx context.Context, field graphql.CollectedField, obj *UpdateSessionPayload) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setImageOrientation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UpdateSessionPayload_session,
+		ec.fieldContext_Mutation_setImageOrientation,
 		func(ctx context.Context) (any, error) {
-			return obj.Session, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SetImageOrientation(ctx, fc.Args["input"].(SetImageOrientationInput))
 		},
 		nil,
-		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		ec.marshalNSetImageOrientationPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageOrientationPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UpdateSessionPayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setImageOrientation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UpdateSessionPayload",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Session_id(ctx, field)
-			case "directory":
-				return ec.fieldContext_Session_directory(ctx, field)
-			case "filter":
-				return ec.fieldContext_Session_filter(ctx, field)
-			case "targetKeep":
-				return ec.fieldContext_Session_targetKeep(ctx, field)
-			case "stats":
-				return ec.fieldContext_Session_stats(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Session_updatedAt(ctx, field)
-			case "canCommit":
-				return ec.fieldContext_Session_canCommit(ctx, field)
-			case "canUndo":
-				return ec.fieldContext_Session_canUndo(ctx, field)
-			case "currentIndex":
-				return ec.fieldContext_Session_currentIndex(ctx, field)
-			case "currentSize":
-				return ec.fieldContext_Session_currentSize(ctx, field)
-			case "currentImage":
-				return ec.fieldContext_Session_currentImage(ctx, field)
-			case "nextImages":
-				return ec.fieldContext_Session_nextImages(ctx, field)
-			case "keptImages":
-				return ec.fieldContext_Session_keptImages(ctx, field)
+			case "session":
+				return ec.fieldContext_SetImageOrientationPayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_SetImageOrientationPayload_clientMutationId(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SetImageOrientationPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setImageOrientation_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UpdateSessionPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *UpdateSessionPayload) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_undo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UpdateSessionPayload_clientMutationId,
+		ec.fieldContext_Mutation_undo,
 		func(ctx context.Context) (any, error) {
-			return obj.ClientMutationID, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Undo(ctx, fc.Args["input"].(UndoInput))
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOUndoPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐUndoPayload,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UpdateSessionPayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_undo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UpdateSessionPayload",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "session":
+				return ec.fieldContext_UndoPayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_UndoPayload_clientMutationId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UndoPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_undo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WriteActions_keepRating(ctx context.Context, field graphql.CollectedField, obj *shared.WriteActions) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WriteActions_keepRating,
+		ec.fieldContext_Mutation_updateSession,
 		func(ctx context.Context) (any, error) {
-			return obj.KeepRating, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateSession(ctx, fc.Args["input"].(UpdateSessionInput))
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNUpdateSessionPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐUpdateSessionPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_WriteActions_keepRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WriteActions",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "session":
+				return ec.fieldContext_UpdateSessionPayload_session(ctx, field)
+			case "clientMutationId":
+				return ec.fieldContext_UpdateSessionPayload_clientMutationId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdateSessionPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WriteActions_shelveRating(ctx context.Context, field graphql.CollectedField, obj *shared.WriteActions) (ret graphql.Marshaler) {
+func (ec *executionContext) _PurgeImageCachePayload_count(ctx context.Context, field graphql.CollectedField, obj *PurgeImageCachePayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WriteActions_shelveRating,
+		ec.fieldContext_PurgeImageCachePayload_count,
 		func(ctx context.Context) (any, error) {
-			return obj.ShelveRating, nil
+			return obj.Count, nil
 		},
 		nil,
 		ec.marshalNInt2int,
@@ -3917,9 +4871,9 @@ func (ec *executionContext) _WriteActions_shelveRating(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext_WriteActions_shelveRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeImageCachePayload_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WriteActions",
+		Object:     "PurgeImageCachePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3930,54 +4884,66 @@ func (ec *executionContext) fieldContext_WriteActions_shelveRating(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _WriteActions_rejectRating(ctx context.Context, field graphql.CollectedField, obj *shared.WriteActions) (ret graphql.Marshaler) {
+func (ec *executionContext) _PurgeImageCachePayload_stats(ctx context.Context, field graphql.CollectedField, obj *PurgeImageCachePayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WriteActions_rejectRating,
+		ec.fieldContext_PurgeImageCachePayload_stats,
 		func(ctx context.Context) (any, error) {
-			return obj.RejectRating, nil
+			return obj.Stats, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNImageCacheStats2ᚖmainᚋinternalᚋsharedᚐImageCacheStatsDTO,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_WriteActions_rejectRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeImageCachePayload_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WriteActions",
+		Object:     "PurgeImageCachePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "hits":
+				return ec.fieldContext_ImageCacheStats_hits(ctx, field)
+			case "misses":
+				return ec.fieldContext_ImageCacheStats_misses(ctx, field)
+			case "count":
+				return ec.fieldContext_ImageCacheStats_count(ctx, field)
+			case "size":
+				return ec.fieldContext_ImageCacheStats_size(ctx, field)
+			case "maxSize":
+				return ec.fieldContext_ImageCacheStats_maxSize(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageCacheStats", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _PurgeImageCachePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *PurgeImageCachePayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_name,
+		ec.fieldContext_PurgeImageCachePayload_clientMutationId,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.ClientMutationID, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeImageCachePayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PurgeImageCachePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3988,131 +4954,223 @@ func (ec *executionContext) fieldContext___Directive_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_node(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_description,
+		ec.fieldContext_Query_node,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Node(ctx, fc.Args["id"].(scalar.ID))
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalONode2mainᚋinternalᚋinterfacesᚋgraphqlᚐNode,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("FieldContext.Child cannot be called on type INTERFACE")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_node_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_imageCacheStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_isRepeatable,
+		ec.fieldContext_Query_imageCacheStats,
 		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
+			return ec.resolvers.Query().ImageCacheStats(ctx)
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNImageCacheStats2ᚖmainᚋinternalᚋsharedᚐImageCacheStatsDTO,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_imageCacheStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "hits":
+				return ec.fieldContext_ImageCacheStats_hits(ctx, field)
+			case "misses":
+				return ec.fieldContext_ImageCacheStats_misses(ctx, field)
+			case "count":
+				return ec.fieldContext_ImageCacheStats_count(ctx, field)
+			case "size":
+				return ec.fieldContext_ImageCacheStats_size(ctx, field)
+			case "maxSize":
+				return ec.fieldContext_ImageCacheStats_maxSize(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageCacheStats", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_meta(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_locations,
+		ec.fieldContext_Query_meta,
 		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
+			return ec.resolvers.Query().Meta(ctx)
 		},
 		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		ec.marshalNMeta2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐMeta,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_meta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "rootPath":
+				return ec.fieldContext_Meta_rootPath(ctx, field)
+			case "version":
+				return ec.fieldContext_Meta_version(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Meta", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_rootDirectory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_args,
+		ec.fieldContext_Query_rootDirectory,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return ec.resolvers.Query().RootDirectory(ctx)
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_rootDirectory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			case "id":
+				return ec.fieldContext_Directory_id(ctx, field)
+			case "parentId":
+				return ec.fieldContext_Directory_parentId(ctx, field)
+			case "path":
+				return ec.fieldContext_Directory_path(ctx, field)
+			case "root":
+				return ec.fieldContext_Directory_root(ctx, field)
+			case "stats":
+				return ec.fieldContext_Directory_stats(ctx, field)
+			case "directories":
+				return ec.fieldContext_Directory_directories(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Directory", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_session(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_session,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Session(ctx, fc.Args["id"].(scalar.ID))
+		},
+		nil,
+		ec.marshalOSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_session(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
 	}
 	defer func() {
@@ -4122,485 +5180,522 @@ func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, f
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_session_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_sidecarIssues(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_name,
+		ec.fieldContext_Query_sidecarIssues,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().SidecarIssues(ctx, fc.Args["directoryId"].(*scalar.ID))
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNSidecarIssue2ᚕᚖmainᚋinternalᚋsharedᚐSidecarIssueDTOᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_sidecarIssues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SidecarIssue_id(ctx, field)
+			case "kind":
+				return ec.fieldContext_SidecarIssue_kind(ctx, field)
+			case "path":
+				return ec.fieldContext_SidecarIssue_path(ctx, field)
+			case "imagePath":
+				return ec.fieldContext_SidecarIssue_imagePath(ctx, field)
+			case "modTime":
+				return ec.fieldContext_SidecarIssue_modTime(ctx, field)
+			case "error":
+				return ec.fieldContext_SidecarIssue_error(ctx, field)
+			case "salvageableRating":
+				return ec.fieldContext_SidecarIssue_salvageableRating(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SidecarIssue", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sidecarIssues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_description,
+		ec.fieldContext_Query___type,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_isDeprecated,
+		ec.fieldContext_Query___schema,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return ec.introspectSchema()
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _RatingCount_rating(ctx context.Context, field graphql.CollectedField, obj *RatingCount) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_deprecationReason,
+		ec.fieldContext_RatingCount_rating,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Rating, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RatingCount_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "RatingCount",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _RatingCount_count(ctx context.Context, field graphql.CollectedField, obj *RatingCount) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_name,
+		ec.fieldContext_RatingCount_count,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Count, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RatingCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "RatingCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ResolveSidecarIssuePayload_rating(ctx context.Context, field graphql.CollectedField, obj *ResolveSidecarIssuePayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_description,
+		ec.fieldContext_ResolveSidecarIssuePayload_rating,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Rating, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOInt2ᚖint,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResolveSidecarIssuePayload_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ResolveSidecarIssuePayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ResolveSidecarIssuePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *ResolveSidecarIssuePayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_args,
+		ec.fieldContext_ResolveSidecarIssuePayload_clientMutationId,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.ClientMutationID, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResolveSidecarIssuePayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ResolveSidecarIssuePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_id(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_type,
+		ec.fieldContext_Session_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNID2mainᚋinternalᚋscalarᚐID,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Session",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_directory(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_isDeprecated,
+		ec.fieldContext_Session_directory,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return ec.resolvers.Session().Directory(ctx, obj)
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_directory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Session",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Directory_id(ctx, field)
+			case "parentId":
+				return ec.fieldContext_Directory_parentId(ctx, field)
+			case "path":
+				return ec.fieldContext_Directory_path(ctx, field)
+			case "root":
+				return ec.fieldContext_Directory_root(ctx, field)
+			case "stats":
+				return ec.fieldContext_Directory_stats(ctx, field)
+			case "directories":
+				return ec.fieldContext_Directory_directories(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Directory", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_filter(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_deprecationReason,
+		ec.fieldContext_Session_filter,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Filter, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNImageFilters2ᚖmainᚋinternalᚋsharedᚐImageFilters,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_filter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "rating":
+				return ec.fieldContext_ImageFilters_rating(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImageFilters", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_targetKeep(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_name,
+		ec.fieldContext_Session_targetKeep,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.TargetKeep, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_targetKeep(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Session",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_stats(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_description,
+		ec.fieldContext_Session_stats,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Stats, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNSessionStats2ᚖmainᚋinternalᚋsharedᚐStatsDTO,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_SessionStats_total(ctx, field)
+			case "kept":
+				return ec.fieldContext_SessionStats_kept(ctx, field)
+			case "shelved":
+				return ec.fieldContext_SessionStats_shelved(ctx, field)
+			case "rejected":
+				return ec.fieldContext_SessionStats_rejected(ctx, field)
+			case "remaining":
+				return ec.fieldContext_SessionStats_remaining(ctx, field)
+			case "isCompleted":
+				return ec.fieldContext_SessionStats_isCompleted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionStats", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_createdAt(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_type,
+		ec.fieldContext_Session_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return ec.resolvers.Session().CreatedAt(ctx, obj)
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_updatedAt(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_defaultValue,
+		ec.fieldContext_Session_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
+			return ec.resolvers.Session().UpdatedAt(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -4608,14 +5703,14 @@ func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_canCommit(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_isDeprecated,
+		ec.fieldContext_Session_canCommit,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.CanCommit, nil
 		},
 		nil,
 		ec.marshalNBoolean2bool,
@@ -4624,11 +5719,11 @@ func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_canCommit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Boolean does not have child fields")
@@ -4637,412 +5732,489 @@ func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_canUndo(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_deprecationReason,
+		ec.fieldContext_Session_canUndo,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.CanUndo, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_canUndo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_currentIndex(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_description,
+		ec.fieldContext_Session_currentIndex,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.CurrentIndex, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_currentIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_currentSize(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_types,
+		ec.fieldContext_Session_currentSize,
 		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
+			return obj.CurrentSize, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_currentSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_currentImage(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_queryType,
+		ec.fieldContext_Session_currentImage,
 		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
+			return obj.CurrentImage, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
+		ec.marshalOImage2ᚖmainᚋinternalᚋsharedᚐImageDTO,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_currentImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "id":
+				return ec.fieldContext_Image_id(ctx, field)
+			case "filename":
+				return ec.fieldContext_Image_filename(ctx, field)
+			case "size":
+				return ec.fieldContext_Image_size(ctx, field)
+			case "url":
+				return ec.fieldContext_Image_url(ctx, field)
+			case "srcset":
+				return ec.fieldContext_Image_srcset(ctx, field)
+			case "placeholder":
+				return ec.fieldContext_Image_placeholder(ctx, field)
+			case "modTime":
+				return ec.fieldContext_Image_modTime(ctx, field)
+			case "width":
+				return ec.fieldContext_Image_width(ctx, field)
+			case "height":
+				return ec.fieldContext_Image_height(ctx, field)
+			case "orientation":
+				return ec.fieldContext_Image_orientation(ctx, field)
+			case "currentRating":
+				return ec.fieldContext_Image_currentRating(ctx, field)
+			case "xmpExists":
+				return ec.fieldContext_Image_xmpExists(ctx, field)
+			case "note":
+				return ec.fieldContext_Image_note(ctx, field)
+			case "history":
+				return ec.fieldContext_Image_history(ctx, field)
+			case "xmpError":
+				return ec.fieldContext_Image_xmpError(ctx, field)
+			case "tileSource":
+				return ec.fieldContext_Image_tileSource(ctx, field)
+			case "mediaType":
+				return ec.fieldContext_Image_mediaType(ctx, field)
+			case "duration":
+				return ec.fieldContext_Image_duration(ctx, field)
+			case "videoUrl":
+				return ec.fieldContext_Image_videoUrl(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_loadProgress(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_mutationType,
+		ec.fieldContext_Session_loadProgress,
 		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
+			return obj.LoadProgress, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNSessionLoadProgress2ᚖmainᚋinternalᚋsharedᚐSessionLoadProgressDTO,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_loadProgress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Session",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "sessionId":
+				return ec.fieldContext_SessionLoadProgress_sessionId(ctx, field)
+			case "state":
+				return ec.fieldContext_SessionLoadProgress_state(ctx, field)
+			case "filesSeen":
+				return ec.fieldContext_SessionLoadProgress_filesSeen(ctx, field)
+			case "imagesAccepted":
+				return ec.fieldContext_SessionLoadProgress_imagesAccepted(ctx, field)
+			case "error":
+				return ec.fieldContext_SessionLoadProgress_error(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SessionLoadProgress", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_nextImages(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_subscriptionType,
+		ec.fieldContext_Session_nextImages,
 		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Session().NextImages(ctx, obj, fc.Args["count"].(*int))
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImageDTOᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_nextImages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Session",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "id":
+				return ec.fieldContext_Image_id(ctx, field)
+			case "filename":
+				return ec.fieldContext_Image_filename(ctx, field)
+			case "size":
+				return ec.fieldContext_Image_size(ctx, field)
+			case "url":
+				return ec.fieldContext_Image_url(ctx, field)
+			case "srcset":
+				return ec.fieldContext_Image_srcset(ctx, field)
+			case "placeholder":
+				return ec.fieldContext_Image_placeholder(ctx, field)
+			case "modTime":
+				return ec.fieldContext_Image_modTime(ctx, field)
+			case "width":
+				return ec.fieldContext_Image_width(ctx, field)
+			case "height":
+				return ec.fieldContext_Image_height(ctx, field)
+			case "orientation":
+				return ec.fieldContext_Image_orientation(ctx, field)
+			case "currentRating":
+				return ec.fieldContext_Image_currentRating(ctx, field)
+			case "xmpExists":
+				return ec.fieldContext_Image_xmpExists(ctx, field)
+			case "note":
+				return ec.fieldContext_Image_note(ctx, field)
+			case "history":
+				return ec.fieldContext_Image_history(ctx, field)
+			case "xmpError":
+				return ec.fieldContext_Image_xmpError(ctx, field)
+			case "tileSource":
+				return ec.fieldContext_Image_tileSource(ctx, field)
+			case "mediaType":
+				return ec.fieldContext_Image_mediaType(ctx, field)
+			case "duration":
+				return ec.fieldContext_Image_duration(ctx, field)
+			case "videoUrl":
+				return ec.fieldContext_Image_videoUrl(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Session_nextImages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Session_keptImages(ctx context.Context, field graphql.CollectedField, obj *shared.SessionDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_directives,
+		ec.fieldContext_Session_keptImages,
 		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Session().KeptImages(ctx, obj, fc.Args["limit"].(*int), fc.Args["offset"].(*int))
 		},
 		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		ec.marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImageDTOᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Session_keptImages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Session",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
+			case "id":
+				return ec.fieldContext_Image_id(ctx, field)
+			case "filename":
+				return ec.fieldContext_Image_filename(ctx, field)
+			case "size":
+				return ec.fieldContext_Image_size(ctx, field)
+			case "url":
+				return ec.fieldContext_Image_url(ctx, field)
+			case "srcset":
+				return ec.fieldContext_Image_srcset(ctx, field)
+			case "placeholder":
+				return ec.fieldContext_Image_placeholder(ctx, field)
+			case "modTime":
+				return ec.fieldContext_Image_modTime(ctx, field)
+			case "width":
+				return ec.fieldContext_Image_width(ctx, field)
+			case "height":
+				return ec.fieldContext_Image_height(ctx, field)
+			case "orientation":
+				return ec.fieldContext_Image_orientation(ctx, field)
+			case "currentRating":
+				return ec.fieldContext_Image_currentRating(ctx, field)
+			case "xmpExists":
+				return ec.fieldContext_Image_xmpExists(ctx, field)
+			case "note":
+				return ec.fieldContext_Image_note(ctx, field)
+			case "history":
+				return ec.fieldContext_Image_history(ctx, field)
+			case "xmpError":
+				return ec.fieldContext_Image_xmpError(ctx, field)
+			case "tileSource":
+				return ec.fieldContext_Image_tileSource(ctx, field)
+			case "mediaType":
+				return ec.fieldContext_Image_mediaType(ctx, field)
+			case "duration":
+				return ec.fieldContext_Image_duration(ctx, field)
+			case "videoUrl":
+				return ec.fieldContext_Image_videoUrl(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Image", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Session_keptImages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _SessionLoadProgress_sessionId(ctx context.Context, field graphql.CollectedField, obj *shared.SessionLoadProgressDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_kind,
+		ec.fieldContext_SessionLoadProgress_sessionId,
 		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
+			return obj.SessionID, nil
 		},
 		nil,
-		ec.marshalN__TypeKind2string,
+		ec.marshalNID2mainᚋinternalᚋscalarᚐID,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SessionLoadProgress_sessionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SessionLoadProgress",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _SessionLoadProgress_state(ctx context.Context, field graphql.CollectedField, obj *shared.SessionLoadProgressDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_name,
+		ec.fieldContext_SessionLoadProgress_state,
 		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
+			return obj.State, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNSessionLoadState2mainᚋinternalᚋenumᚐEnum,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SessionLoadProgress_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SessionLoadProgress",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type SessionLoadState does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _SessionLoadProgress_filesSeen(ctx context.Context, field graphql.CollectedField, obj *shared.SessionLoadProgressDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_description,
+		ec.fieldContext_SessionLoadProgress_filesSeen,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.FilesSeen, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SessionLoadProgress_filesSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SessionLoadProgress",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _SessionLoadProgress_imagesAccepted(ctx context.Context, field graphql.CollectedField, obj *shared.SessionLoadProgressDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_specifiedByURL,
+		ec.fieldContext_SessionLoadProgress_imagesAccepted,
 		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
+			return obj.ImagesAccepted, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionLoadProgress_imagesAccepted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionLoadProgress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionLoadProgress_error(ctx context.Context, field graphql.CollectedField, obj *shared.SessionLoadProgressDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionLoadProgress_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -5051,11 +6223,11 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SessionLoadProgress_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SessionLoadProgress",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5064,135 +6236,2245 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _SessionStats_total(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_fields,
+		ec.fieldContext_SessionStats_total,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SessionStats_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SessionStats",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _SessionStats_kept(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_interfaces,
+		ec.fieldContext_SessionStats_kept,
 		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
+			return obj.Kept, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SessionStats_kept(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SessionStats",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _SessionStats_shelved(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_possibleTypes,
+		ec.fieldContext_SessionStats_shelved,
 		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
+			return obj.Shelved, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SessionStats_shelved(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SessionStats",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionStats_rejected(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionStats_rejected,
+		func(ctx context.Context) (any, error) {
+			return obj.Rejected, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionStats_rejected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionStats_remaining(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionStats_remaining,
+		func(ctx context.Context) (any, error) {
+			return obj.Remaining, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionStats_remaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionStats_isCompleted(ctx context.Context, field graphql.CollectedField, obj *shared.StatsDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionStats_isCompleted,
+		func(ctx context.Context) (any, error) {
+			return obj.IsCompleted, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionStats_isCompleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetImageNotePayload_session(ctx context.Context, field graphql.CollectedField, obj *SetImageNotePayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SetImageNotePayload_session,
+		func(ctx context.Context) (any, error) {
+			return obj.Session, nil
+		},
+		nil,
+		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SetImageNotePayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetImageNotePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetImageNotePayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *SetImageNotePayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SetImageNotePayload_clientMutationId,
+		func(ctx context.Context) (any, error) {
+			return obj.ClientMutationID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SetImageNotePayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetImageNotePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetImageOrientationPayload_session(ctx context.Context, field graphql.CollectedField, obj *SetImageOrientationPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SetImageOrientationPayload_session,
+		func(ctx context.Context) (any, error) {
+			return obj.Session, nil
+		},
+		nil,
+		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SetImageOrientationPayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetImageOrientationPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetImageOrientationPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *SetImageOrientationPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SetImageOrientationPayload_clientMutationId,
+		func(ctx context.Context) (any, error) {
+			return obj.ClientMutationID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SetImageOrientationPayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetImageOrientationPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SidecarIssue_id(ctx context.Context, field graphql.CollectedField, obj *shared.SidecarIssueDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SidecarIssue_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2mainᚋinternalᚋscalarᚐID,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SidecarIssue_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SidecarIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SidecarIssue_kind(ctx context.Context, field graphql.CollectedField, obj *shared.SidecarIssueDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SidecarIssue_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNSidecarIssueKind2mainᚋinternalᚋenumᚐEnum,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SidecarIssue_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SidecarIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SidecarIssueKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SidecarIssue_path(ctx context.Context, field graphql.CollectedField, obj *shared.SidecarIssueDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SidecarIssue_path,
+		func(ctx context.Context) (any, error) {
+			return obj.Path, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SidecarIssue_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SidecarIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SidecarIssue_imagePath(ctx context.Context, field graphql.CollectedField, obj *shared.SidecarIssueDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SidecarIssue_imagePath,
+		func(ctx context.Context) (any, error) {
+			return obj.ImagePath, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SidecarIssue_imagePath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SidecarIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SidecarIssue_modTime(ctx context.Context, field graphql.CollectedField, obj *shared.SidecarIssueDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SidecarIssue_modTime,
+		func(ctx context.Context) (any, error) {
+			return obj.ModTime, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SidecarIssue_modTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SidecarIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SidecarIssue_error(ctx context.Context, field graphql.CollectedField, obj *shared.SidecarIssueDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SidecarIssue_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SidecarIssue_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SidecarIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SidecarIssue_salvageableRating(ctx context.Context, field graphql.CollectedField, obj *shared.SidecarIssueDTO) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SidecarIssue_salvageableRating,
+		func(ctx context.Context) (any, error) {
+			return obj.SalvageableRating, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SidecarIssue_salvageableRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SidecarIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_sessionUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_sessionUpdated,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().SessionUpdated(ctx, fc.Args["id"].(scalar.ID))
+		},
+		nil,
+		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_sessionUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_sessionUpdated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_directoryChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_directoryChanged,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().DirectoryChanged(ctx, fc.Args["filterBy"].(*shared.DirectoryFilters))
+		},
+		nil,
+		ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_directoryChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Directory_id(ctx, field)
+			case "parentId":
+				return ec.fieldContext_Directory_parentId(ctx, field)
+			case "path":
+				return ec.fieldContext_Directory_path(ctx, field)
+			case "root":
+				return ec.fieldContext_Directory_root(ctx, field)
+			case "stats":
+				return ec.fieldContext_Directory_stats(ctx, field)
+			case "directories":
+				return ec.fieldContext_Directory_directories(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Directory", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_directoryChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_sessionLoadProgress(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_sessionLoadProgress,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().SessionLoadProgress(ctx, fc.Args["id"].(scalar.ID))
+		},
+		nil,
+		ec.marshalNSessionLoadProgress2ᚖmainᚋinternalᚋsharedᚐSessionLoadProgressDTO,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_sessionLoadProgress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sessionId":
+				return ec.fieldContext_SessionLoadProgress_sessionId(ctx, field)
+			case "state":
+				return ec.fieldContext_SessionLoadProgress_state(ctx, field)
+			case "filesSeen":
+				return ec.fieldContext_SessionLoadProgress_filesSeen(ctx, field)
+			case "imagesAccepted":
+				return ec.fieldContext_SessionLoadProgress_imagesAccepted(ctx, field)
+			case "error":
+				return ec.fieldContext_SessionLoadProgress_error(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionLoadProgress", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_sessionLoadProgress_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UndoPayload_session(ctx context.Context, field graphql.CollectedField, obj *UndoPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UndoPayload_session,
+		func(ctx context.Context) (any, error) {
+			return obj.Session, nil
+		},
+		nil,
+		ec.marshalOSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UndoPayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UndoPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UndoPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *UndoPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UndoPayload_clientMutationId,
+		func(ctx context.Context) (any, error) {
+			return obj.ClientMutationID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UndoPayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UndoPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateSessionPayload_session(ctx context.Context, field graphql.CollectedField, obj *UpdateSessionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateSessionPayload_session,
+		func(ctx context.Context) (any, error) {
+			return obj.Session, nil
+		},
+		nil,
+		ec.marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessionDTO,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateSessionPayload_session(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateSessionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "directory":
+				return ec.fieldContext_Session_directory(ctx, field)
+			case "filter":
+				return ec.fieldContext_Session_filter(ctx, field)
+			case "targetKeep":
+				return ec.fieldContext_Session_targetKeep(ctx, field)
+			case "stats":
+				return ec.fieldContext_Session_stats(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Session_updatedAt(ctx, field)
+			case "canCommit":
+				return ec.fieldContext_Session_canCommit(ctx, field)
+			case "canUndo":
+				return ec.fieldContext_Session_canUndo(ctx, field)
+			case "currentIndex":
+				return ec.fieldContext_Session_currentIndex(ctx, field)
+			case "currentSize":
+				return ec.fieldContext_Session_currentSize(ctx, field)
+			case "currentImage":
+				return ec.fieldContext_Session_currentImage(ctx, field)
+			case "loadProgress":
+				return ec.fieldContext_Session_loadProgress(ctx, field)
+			case "nextImages":
+				return ec.fieldContext_Session_nextImages(ctx, field)
+			case "keptImages":
+				return ec.fieldContext_Session_keptImages(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateSessionPayload_clientMutationId(ctx context.Context, field graphql.CollectedField, obj *UpdateSessionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateSessionPayload_clientMutationId,
+		func(ctx context.Context) (any, error) {
+			return obj.ClientMutationID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateSessionPayload_clientMutationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateSessionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WriteActions_keepRating(ctx context.Context, field graphql.CollectedField, obj *shared.WriteActions) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WriteActions_keepRating,
+		func(ctx context.Context) (any, error) {
+			return obj.KeepRating, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WriteActions_keepRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WriteActions",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WriteActions_shelveRating(ctx context.Context, field graphql.CollectedField, obj *shared.WriteActions) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WriteActions_shelveRating,
+		func(ctx context.Context) (any, error) {
+			return obj.ShelveRating, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WriteActions_shelveRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WriteActions",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WriteActions_rejectRating(ctx context.Context, field graphql.CollectedField, obj *shared.WriteActions) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WriteActions_rejectRating,
+		func(ctx context.Context) (any, error) {
+			return obj.RejectRating, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WriteActions_rejectRating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WriteActions",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
@@ -5405,6 +8687,40 @@ func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputCancelSessionCreationInput(ctx context.Context, obj any) (CancelSessionCreationInput, error) {
+	var it CancelSessionCreationInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sessionId", "clientMutationId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sessionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sessionId"))
+			data, err := ec.unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SessionID = data
+		case "clientMutationId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClientMutationID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCommitChangesInput(ctx context.Context, obj any) (CommitChangesInput, error) {
 	var it CommitChangesInput
 	asMap := map[string]any{}
@@ -5412,7 +8728,7 @@ func (ec *executionContext) unmarshalInputCommitChangesInput(ctx context.Context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sessionId", "writeActions", "clientMutationId"}
+	fieldsInOrder := [...]string{"sessionId", "writeActions", "conflictPolicy", "clientMutationId"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5433,6 +8749,13 @@ func (ec *executionContext) unmarshalInputCommitChangesInput(ctx context.Context
 				return it, err
 			}
 			it.WriteActions = data
+		case "conflictPolicy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("conflictPolicy"))
+			data, err := ec.unmarshalOXMPConflictPolicy2ᚖmainᚋinternalᚋenumᚐEnum(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ConflictPolicy = data
 		case "clientMutationId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
@@ -5541,21 +8864,199 @@ func (ec *executionContext) unmarshalInputImageFiltersInput(ctx context.Context,
 			if err != nil {
 				return it, err
 			}
-			it.Rating = data
+			it.Rating = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMarkImageInput(ctx context.Context, obj any) (MarkImageInput, error) {
+	var it MarkImageInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sessionId", "imageId", "action", "duration", "clientMutationId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sessionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sessionId"))
+			data, err := ec.unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SessionID = data
+		case "imageId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("imageId"))
+			data, err := ec.unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ImageID = data
+		case "action":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
+			data, err := ec.unmarshalNImageAction2mainᚋinternalᚋenumᚐEnum(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Action = data
+		case "duration":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("duration"))
+			data, err := ec.unmarshalODuration2ᚖmainᚋinternalᚋscalarᚐDuration(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Duration = data
+		case "clientMutationId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClientMutationID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPurgeImageCacheInput(ctx context.Context, obj any) (PurgeImageCacheInput, error) {
+	var it PurgeImageCacheInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"path", "clientMutationId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		case "clientMutationId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClientMutationID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputResolveSidecarIssueInput(ctx context.Context, obj any) (ResolveSidecarIssueInput, error) {
+	var it ResolveSidecarIssueInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "action", "clientMutationId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "action":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
+			data, err := ec.unmarshalNSidecarRecoveryAction2mainᚋinternalᚋenumᚐEnum(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Action = data
+		case "clientMutationId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClientMutationID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetImageNoteInput(ctx context.Context, obj any) (SetImageNoteInput, error) {
+	var it SetImageNoteInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sessionId", "imageId", "note", "clientMutationId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sessionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sessionId"))
+			data, err := ec.unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SessionID = data
+		case "imageId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("imageId"))
+			data, err := ec.unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ImageID = data
+		case "note":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("note"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Note = data
+		case "clientMutationId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClientMutationID = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputMarkImageInput(ctx context.Context, obj any) (MarkImageInput, error) {
-	var it MarkImageInput
+func (ec *executionContext) unmarshalInputSetImageOrientationInput(ctx context.Context, obj any) (SetImageOrientationInput, error) {
+	var it SetImageOrientationInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sessionId", "imageId", "action", "duration", "clientMutationId"}
+	fieldsInOrder := [...]string{"sessionId", "imageId", "orientation", "clientMutationId"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5576,20 +9077,13 @@ func (ec *executionContext) unmarshalInputMarkImageInput(ctx context.Context, ob
 				return it, err
 			}
 			it.ImageID = data
-		case "action":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
-			data, err := ec.unmarshalNImageAction2mainᚋinternalᚋenumᚐEnum(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Action = data
-		case "duration":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("duration"))
-			data, err := ec.unmarshalODuration2ᚖmainᚋinternalᚋscalarᚐDuration(ctx, v)
+		case "orientation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orientation"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Duration = data
+			it.Orientation = data
 		case "clientMutationId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientMutationId"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
@@ -5754,6 +9248,47 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 
 // region    **************************** object.gotpl ****************************
 
+var cancelSessionCreationPayloadImplementors = []string{"CancelSessionCreationPayload"}
+
+func (ec *executionContext) _CancelSessionCreationPayload(ctx context.Context, sel ast.SelectionSet, obj *CancelSessionCreationPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cancelSessionCreationPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CancelSessionCreationPayload")
+		case "session":
+			out.Values[i] = ec._CancelSessionCreationPayload_session(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "clientMutationId":
+			out.Values[i] = ec._CancelSessionCreationPayload_clientMutationId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var commitChangesPayloadImplementors = []string{"CommitChangesPayload"}
 
 func (ec *executionContext) _CommitChangesPayload(ctx context.Context, sel ast.SelectionSet, obj *CommitChangesPayload) graphql.Marshaler {
@@ -5802,19 +9337,221 @@ var createSessionPayloadImplementors = []string{"CreateSessionPayload"}
 func (ec *executionContext) _CreateSessionPayload(ctx context.Context, sel ast.SelectionSet, obj *CreateSessionPayload) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, createSessionPayloadImplementors)
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateSessionPayload")
-		case "session":
-			out.Values[i] = ec._CreateSessionPayload_session(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateSessionPayload")
+		case "session":
+			out.Values[i] = ec._CreateSessionPayload_session(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "clientMutationId":
+			out.Values[i] = ec._CreateSessionPayload_clientMutationId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var directoryImplementors = []string{"Directory", "Node"}
+
+func (ec *executionContext) _Directory(ctx context.Context, sel ast.SelectionSet, obj *shared.DirectoryDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, directoryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Directory")
+		case "id":
+			out.Values[i] = ec._Directory_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "parentId":
+			out.Values[i] = ec._Directory_parentId(ctx, field, obj)
+		case "path":
+			out.Values[i] = ec._Directory_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "root":
+			out.Values[i] = ec._Directory_root(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "stats":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Directory_stats(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "directories":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Directory_directories(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var directoryStatsImplementors = []string{"DirectoryStats"}
+
+func (ec *executionContext) _DirectoryStats(ctx context.Context, sel ast.SelectionSet, obj *shared.DirectoryStatsDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, directoryStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DirectoryStats")
+		case "imageCount":
+			out.Values[i] = ec._DirectoryStats_imageCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "subdirectoryCount":
+			out.Values[i] = ec._DirectoryStats_subdirectoryCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "latestImage":
+			out.Values[i] = ec._DirectoryStats_latestImage(ctx, field, obj)
+		case "ratingCounts":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DirectoryStats_ratingCounts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-		case "clientMutationId":
-			out.Values[i] = ec._CreateSessionPayload_clientMutationId(ctx, field, obj)
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5838,35 +9575,105 @@ func (ec *executionContext) _CreateSessionPayload(ctx context.Context, sel ast.S
 	return out
 }
 
-var directoryImplementors = []string{"Directory", "Node"}
+var imageImplementors = []string{"Image"}
 
-func (ec *executionContext) _Directory(ctx context.Context, sel ast.SelectionSet, obj *shared.DirectoryDTO) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, directoryImplementors)
+func (ec *executionContext) _Image(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, imageImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Directory")
+			out.Values[i] = graphql.MarshalString("Image")
 		case "id":
-			out.Values[i] = ec._Directory_id(ctx, field, obj)
+			out.Values[i] = ec._Image_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "parentId":
-			out.Values[i] = ec._Directory_parentId(ctx, field, obj)
-		case "path":
-			out.Values[i] = ec._Directory_path(ctx, field, obj)
+		case "filename":
+			out.Values[i] = ec._Image_filename(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "root":
-			out.Values[i] = ec._Directory_root(ctx, field, obj)
+		case "size":
+			out.Values[i] = ec._Image_size(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "stats":
+		case "url":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Image_url(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "srcset":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Image_srcset(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "placeholder":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -5875,7 +9682,7 @@ func (ec *executionContext) _Directory(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Directory_stats(ctx, field, obj)
+				res = ec._Image_placeholder(ctx, field, obj)
 				return res
 			}
 
@@ -5899,7 +9706,43 @@ func (ec *executionContext) _Directory(ctx context.Context, sel ast.SelectionSet
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "directories":
+		case "modTime":
+			out.Values[i] = ec._Image_modTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "width":
+			out.Values[i] = ec._Image_width(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "height":
+			out.Values[i] = ec._Image_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "orientation":
+			out.Values[i] = ec._Image_orientation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "currentRating":
+			out.Values[i] = ec._Image_currentRating(ctx, field, obj)
+		case "xmpExists":
+			out.Values[i] = ec._Image_xmpExists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "note":
+			out.Values[i] = ec._Image_note(ctx, field, obj)
+		case "history":
+			out.Values[i] = ec._Image_history(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "xmpError":
+			out.Values[i] = ec._Image_xmpError(ctx, field, obj)
+		case "tileSource":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -5908,7 +9751,7 @@ func (ec *executionContext) _Directory(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Directory_directories(ctx, field, obj)
+				res = ec._Image_tileSource(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -5929,12 +9772,147 @@ func (ec *executionContext) _Directory(ctx context.Context, sel ast.SelectionSet
 					return innerFunc(ctx, dfs)
 				})
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "mediaType":
+			out.Values[i] = ec._Image_mediaType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "duration":
+			out.Values[i] = ec._Image_duration(ctx, field, obj)
+		case "videoUrl":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Image_videoUrl(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var imageCacheStatsImplementors = []string{"ImageCacheStats"}
+
+func (ec *executionContext) _ImageCacheStats(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageCacheStatsDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, imageCacheStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImageCacheStats")
+		case "hits":
+			out.Values[i] = ec._ImageCacheStats_hits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "misses":
+			out.Values[i] = ec._ImageCacheStats_misses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._ImageCacheStats_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "size":
+			out.Values[i] = ec._ImageCacheStats_size(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxSize":
+			out.Values[i] = ec._ImageCacheStats_maxSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var imageFiltersImplementors = []string{"ImageFilters"}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+func (ec *executionContext) _ImageFilters(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageFilters) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, imageFiltersImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImageFilters")
+		case "rating":
+			out.Values[i] = ec._ImageFilters_rating(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5958,65 +9936,30 @@ func (ec *executionContext) _Directory(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var directoryStatsImplementors = []string{"DirectoryStats"}
+var imageHistoryEventImplementors = []string{"ImageHistoryEvent"}
 
-func (ec *executionContext) _DirectoryStats(ctx context.Context, sel ast.SelectionSet, obj *shared.DirectoryStatsDTO) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, directoryStatsImplementors)
+func (ec *executionContext) _ImageHistoryEvent(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageHistoryEventDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, imageHistoryEventImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DirectoryStats")
-		case "imageCount":
-			out.Values[i] = ec._DirectoryStats_imageCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "subdirectoryCount":
-			out.Values[i] = ec._DirectoryStats_subdirectoryCount(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ImageHistoryEvent")
+		case "action":
+			out.Values[i] = ec._ImageHistoryEvent_action(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "latestImage":
-			out.Values[i] = ec._DirectoryStats_latestImage(ctx, field, obj)
-		case "ratingCounts":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._DirectoryStats_ratingCounts(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "rating":
+			out.Values[i] = ec._ImageHistoryEvent_rating(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._ImageHistoryEvent_timestamp(ctx, field, obj)
+		case "softwareAgent":
+			out.Values[i] = ec._ImageHistoryEvent_softwareAgent(ctx, field, obj)
+		case "sessionId":
+			out.Values[i] = ec._ImageHistoryEvent_sessionId(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6040,89 +9983,75 @@ func (ec *executionContext) _DirectoryStats(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var imageImplementors = []string{"Image"}
+var imageSrcsetImplementors = []string{"ImageSrcset"}
 
-func (ec *executionContext) _Image(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageDTO) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, imageImplementors)
+func (ec *executionContext) _ImageSrcset(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageSrcsetDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, imageSrcsetImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Image")
-		case "id":
-			out.Values[i] = ec._Image_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ImageSrcset")
+		case "srcset":
+			out.Values[i] = ec._ImageSrcset_srcset(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "filename":
-			out.Values[i] = ec._Image_filename(ctx, field, obj)
+		case "sizes":
+			out.Values[i] = ec._ImageSrcset_sizes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "size":
-			out.Values[i] = ec._Image_size(ctx, field, obj)
+		case "candidates":
+			out.Values[i] = ec._ImageSrcset_candidates(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "url":
-			field := field
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Image_url(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "modTime":
-			out.Values[i] = ec._Image_modTime(ctx, field, obj)
+var imageSrcsetCandidateImplementors = []string{"ImageSrcsetCandidate"}
+
+func (ec *executionContext) _ImageSrcsetCandidate(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageSrcsetCandidateDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, imageSrcsetCandidateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImageSrcsetCandidate")
+		case "url":
+			out.Values[i] = ec._ImageSrcsetCandidate_url(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
 		case "width":
-			out.Values[i] = ec._Image_width(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "height":
-			out.Values[i] = ec._Image_height(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "currentRating":
-			out.Values[i] = ec._Image_currentRating(ctx, field, obj)
-		case "xmpExists":
-			out.Values[i] = ec._Image_xmpExists(ctx, field, obj)
+			out.Values[i] = ec._ImageSrcsetCandidate_width(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -6147,19 +10076,47 @@ func (ec *executionContext) _Image(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var imageFiltersImplementors = []string{"ImageFilters"}
+var imageTileSourceImplementors = []string{"ImageTileSource"}
 
-func (ec *executionContext) _ImageFilters(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageFilters) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, imageFiltersImplementors)
+func (ec *executionContext) _ImageTileSource(ctx context.Context, sel ast.SelectionSet, obj *shared.ImageTileSourceDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, imageTileSourceImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ImageFilters")
-		case "rating":
-			out.Values[i] = ec._ImageFilters_rating(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ImageTileSource")
+		case "url":
+			out.Values[i] = ec._ImageTileSource_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "width":
+			out.Values[i] = ec._ImageTileSource_width(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "height":
+			out.Values[i] = ec._ImageTileSource_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tileSize":
+			out.Values[i] = ec._ImageTileSource_tileSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "overlap":
+			out.Values[i] = ec._ImageTileSource_overlap(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxLevel":
+			out.Values[i] = ec._ImageTileSource_maxLevel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6294,6 +10251,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "cancelSessionCreation":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelSessionCreation(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "commitChanges":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_commitChanges(ctx, field)
@@ -6308,17 +10272,91 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "undo":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_undo(ctx, field)
-			})
-		case "updateSession":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateSession(ctx, field)
-			})
+		case "purgeImageCache":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_purgeImageCache(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resolveSidecarIssue":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_resolveSidecarIssue(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setImageNote":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setImageNote(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setImageOrientation":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setImageOrientation(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "undo":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_undo(ctx, field)
+			})
+		case "updateSession":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateSession(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var purgeImageCachePayloadImplementors = []string{"PurgeImageCachePayload"}
+
+func (ec *executionContext) _PurgeImageCachePayload(ctx context.Context, sel ast.SelectionSet, obj *PurgeImageCachePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, purgeImageCachePayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PurgeImageCachePayload")
+		case "count":
+			out.Values[i] = ec._PurgeImageCachePayload_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stats":
+			out.Values[i] = ec._PurgeImageCachePayload_stats(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "clientMutationId":
+			out.Values[i] = ec._PurgeImageCachePayload_clientMutationId(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6379,6 +10417,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "imageCacheStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_imageCacheStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "meta":
 			field := field
@@ -6442,6 +10502,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "sidecarIssues":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sidecarIssues(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -6518,6 +10600,44 @@ func (ec *executionContext) _RatingCount(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var resolveSidecarIssuePayloadImplementors = []string{"ResolveSidecarIssuePayload"}
+
+func (ec *executionContext) _ResolveSidecarIssuePayload(ctx context.Context, sel ast.SelectionSet, obj *ResolveSidecarIssuePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resolveSidecarIssuePayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ResolveSidecarIssuePayload")
+		case "rating":
+			out.Values[i] = ec._ResolveSidecarIssuePayload_rating(ctx, field, obj)
+		case "clientMutationId":
+			out.Values[i] = ec._ResolveSidecarIssuePayload_clientMutationId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var sessionImplementors = []string{"Session"}
 
 func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet, obj *shared.SessionDTO) graphql.Marshaler {
@@ -6679,6 +10799,11 @@ func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet,
 			}
 		case "currentImage":
 			out.Values[i] = ec._Session_currentImage(ctx, field, obj)
+		case "loadProgress":
+			out.Values[i] = ec._Session_loadProgress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "nextImages":
 			field := field
 
@@ -6718,39 +10843,200 @@ func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet,
 		case "keptImages":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Session_keptImages(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Session_keptImages(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sessionLoadProgressImplementors = []string{"SessionLoadProgress"}
+
+func (ec *executionContext) _SessionLoadProgress(ctx context.Context, sel ast.SelectionSet, obj *shared.SessionLoadProgressDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionLoadProgressImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SessionLoadProgress")
+		case "sessionId":
+			out.Values[i] = ec._SessionLoadProgress_sessionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._SessionLoadProgress_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "filesSeen":
+			out.Values[i] = ec._SessionLoadProgress_filesSeen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "imagesAccepted":
+			out.Values[i] = ec._SessionLoadProgress_imagesAccepted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "error":
+			out.Values[i] = ec._SessionLoadProgress_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sessionStatsImplementors = []string{"SessionStats"}
+
+func (ec *executionContext) _SessionStats(ctx context.Context, sel ast.SelectionSet, obj *shared.StatsDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SessionStats")
+		case "total":
+			out.Values[i] = ec._SessionStats_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kept":
+			out.Values[i] = ec._SessionStats_kept(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shelved":
+			out.Values[i] = ec._SessionStats_shelved(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rejected":
+			out.Values[i] = ec._SessionStats_rejected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remaining":
+			out.Values[i] = ec._SessionStats_remaining(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isCompleted":
+			out.Values[i] = ec._SessionStats_isCompleted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var setImageNotePayloadImplementors = []string{"SetImageNotePayload"}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) _SetImageNotePayload(ctx context.Context, sel ast.SelectionSet, obj *SetImageNotePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, setImageNotePayloadImplementors)
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SetImageNotePayload")
+		case "session":
+			out.Values[i] = ec._SetImageNotePayload_session(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "clientMutationId":
+			out.Values[i] = ec._SetImageNotePayload_clientMutationId(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6774,47 +11060,87 @@ func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var sessionStatsImplementors = []string{"SessionStats"}
+var setImageOrientationPayloadImplementors = []string{"SetImageOrientationPayload"}
 
-func (ec *executionContext) _SessionStats(ctx context.Context, sel ast.SelectionSet, obj *shared.StatsDTO) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sessionStatsImplementors)
+func (ec *executionContext) _SetImageOrientationPayload(ctx context.Context, sel ast.SelectionSet, obj *SetImageOrientationPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, setImageOrientationPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SessionStats")
-		case "total":
-			out.Values[i] = ec._SessionStats_total(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SetImageOrientationPayload")
+		case "session":
+			out.Values[i] = ec._SetImageOrientationPayload_session(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "kept":
-			out.Values[i] = ec._SessionStats_kept(ctx, field, obj)
+		case "clientMutationId":
+			out.Values[i] = ec._SetImageOrientationPayload_clientMutationId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sidecarIssueImplementors = []string{"SidecarIssue"}
+
+func (ec *executionContext) _SidecarIssue(ctx context.Context, sel ast.SelectionSet, obj *shared.SidecarIssueDTO) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sidecarIssueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SidecarIssue")
+		case "id":
+			out.Values[i] = ec._SidecarIssue_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "shelved":
-			out.Values[i] = ec._SessionStats_shelved(ctx, field, obj)
+		case "kind":
+			out.Values[i] = ec._SidecarIssue_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "rejected":
-			out.Values[i] = ec._SessionStats_rejected(ctx, field, obj)
+		case "path":
+			out.Values[i] = ec._SidecarIssue_path(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "remaining":
-			out.Values[i] = ec._SessionStats_remaining(ctx, field, obj)
+		case "imagePath":
+			out.Values[i] = ec._SidecarIssue_imagePath(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "isCompleted":
-			out.Values[i] = ec._SessionStats_isCompleted(ctx, field, obj)
+		case "modTime":
+			out.Values[i] = ec._SidecarIssue_modTime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "error":
+			out.Values[i] = ec._SidecarIssue_error(ctx, field, obj)
+		case "salvageableRating":
+			out.Values[i] = ec._SidecarIssue_salvageableRating(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6855,6 +11181,8 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return ec._Subscription_sessionUpdated(ctx, fields[0])
 	case "directoryChanged":
 		return ec._Subscription_directoryChanged(ctx, fields[0])
+	case "sessionLoadProgress":
+		return ec._Subscription_sessionLoadProgress(ctx, fields[0])
 	default:
 		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
@@ -7339,6 +11667,25 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) unmarshalNCancelSessionCreationInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐCancelSessionCreationInput(ctx context.Context, v any) (CancelSessionCreationInput, error) {
+	res, err := ec.unmarshalInputCancelSessionCreationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCancelSessionCreationPayload2mainᚋinternalᚋinterfacesᚋgraphqlᚐCancelSessionCreationPayload(ctx context.Context, sel ast.SelectionSet, v CancelSessionCreationPayload) graphql.Marshaler {
+	return ec._CancelSessionCreationPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCancelSessionCreationPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐCancelSessionCreationPayload(ctx context.Context, sel ast.SelectionSet, v *CancelSessionCreationPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CancelSessionCreationPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNCommitChangesInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐCommitChangesInput(ctx context.Context, v any) (CommitChangesInput, error) {
 	res, err := ec.unmarshalInputCommitChangesInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -7374,14 +11721,171 @@ func (ec *executionContext) marshalNCreateSessionPayload2ᚖmainᚋinternalᚋin
 		}
 		return graphql.Null
 	}
-	return ec._CreateSessionPayload(ctx, sel, v)
+	return ec._CreateSessionPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDirectory2mainᚋinternalᚋsharedᚐDirectoryDTO(ctx context.Context, sel ast.SelectionSet, v shared.DirectoryDTO) graphql.Marshaler {
+	return ec._Directory(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDirectory2ᚕᚖmainᚋinternalᚋsharedᚐDirectoryDTOᚄ(ctx context.Context, sel ast.SelectionSet, v []*shared.DirectoryDTO) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO(ctx context.Context, sel ast.SelectionSet, v *shared.DirectoryDTO) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Directory(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx context.Context, v any) (scalar.ID, error) {
+	var res scalar.ID
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2mainᚋinternalᚋscalarᚐID(ctx context.Context, sel ast.SelectionSet, v scalar.ID) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImageDTOᚄ(ctx context.Context, sel ast.SelectionSet, v []*shared.ImageDTO) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNImage2ᚖmainᚋinternalᚋsharedᚐImageDTO(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNImage2ᚖmainᚋinternalᚋsharedᚐImageDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageDTO) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Image(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNImageAction2mainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (enum.Enum[shared.ImageActionMeta], error) {
+	var res enum.Enum[shared.ImageActionMeta]
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNImageAction2mainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v enum.Enum[shared.ImageActionMeta]) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNImageCacheStats2mainᚋinternalᚋsharedᚐImageCacheStatsDTO(ctx context.Context, sel ast.SelectionSet, v shared.ImageCacheStatsDTO) graphql.Marshaler {
+	return ec._ImageCacheStats(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNImageCacheStats2ᚖmainᚋinternalᚋsharedᚐImageCacheStatsDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageCacheStatsDTO) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ImageCacheStats(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNImageFilters2ᚖmainᚋinternalᚋsharedᚐImageFilters(ctx context.Context, sel ast.SelectionSet, v *shared.ImageFilters) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ImageFilters(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDirectory2mainᚋinternalᚋsharedᚐDirectoryDTO(ctx context.Context, sel ast.SelectionSet, v shared.DirectoryDTO) graphql.Marshaler {
-	return ec._Directory(ctx, sel, &v)
+func (ec *executionContext) unmarshalNImageFiltersInput2ᚖmainᚋinternalᚋsharedᚐImageFilters(ctx context.Context, v any) (*shared.ImageFilters, error) {
+	res, err := ec.unmarshalInputImageFiltersInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDirectory2ᚕᚖmainᚋinternalᚋsharedᚐDirectoryDTOᚄ(ctx context.Context, sel ast.SelectionSet, v []*shared.DirectoryDTO) graphql.Marshaler {
+func (ec *executionContext) marshalNImageHistoryEvent2ᚕᚖmainᚋinternalᚋsharedᚐImageHistoryEventDTOᚄ(ctx context.Context, sel ast.SelectionSet, v []*shared.ImageHistoryEventDTO) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7405,7 +11909,7 @@ func (ec *executionContext) marshalNDirectory2ᚕᚖmainᚋinternalᚋsharedᚐD
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO(ctx, sel, v[i])
+			ret[i] = ec.marshalNImageHistoryEvent2ᚖmainᚋinternalᚋsharedᚐImageHistoryEventDTO(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7425,27 +11929,31 @@ func (ec *executionContext) marshalNDirectory2ᚕᚖmainᚋinternalᚋsharedᚐD
 	return ret
 }
 
-func (ec *executionContext) marshalNDirectory2ᚖmainᚋinternalᚋsharedᚐDirectoryDTO(ctx context.Context, sel ast.SelectionSet, v *shared.DirectoryDTO) graphql.Marshaler {
+func (ec *executionContext) marshalNImageHistoryEvent2ᚖmainᚋinternalᚋsharedᚐImageHistoryEventDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageHistoryEventDTO) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Directory(ctx, sel, v)
+	return ec._ImageHistoryEvent(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2mainᚋinternalᚋscalarᚐID(ctx context.Context, v any) (scalar.ID, error) {
-	var res scalar.ID
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNImageSrcset2mainᚋinternalᚋsharedᚐImageSrcsetDTO(ctx context.Context, sel ast.SelectionSet, v shared.ImageSrcsetDTO) graphql.Marshaler {
+	return ec._ImageSrcset(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNID2mainᚋinternalᚋscalarᚐID(ctx context.Context, sel ast.SelectionSet, v scalar.ID) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNImageSrcset2ᚖmainᚋinternalᚋsharedᚐImageSrcsetDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageSrcsetDTO) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ImageSrcset(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImageDTOᚄ(ctx context.Context, sel ast.SelectionSet, v []*shared.ImageDTO) graphql.Marshaler {
+func (ec *executionContext) marshalNImageSrcsetCandidate2ᚕᚖmainᚋinternalᚋsharedᚐImageSrcsetCandidateDTOᚄ(ctx context.Context, sel ast.SelectionSet, v []*shared.ImageSrcsetCandidateDTO) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7469,7 +11977,7 @@ func (ec *executionContext) marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImage
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNImage2ᚖmainᚋinternalᚋsharedᚐImageDTO(ctx, sel, v[i])
+			ret[i] = ec.marshalNImageSrcsetCandidate2ᚖmainᚋinternalᚋsharedᚐImageSrcsetCandidateDTO(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7489,39 +11997,28 @@ func (ec *executionContext) marshalNImage2ᚕᚖmainᚋinternalᚋsharedᚐImage
 	return ret
 }
 
-func (ec *executionContext) marshalNImage2ᚖmainᚋinternalᚋsharedᚐImageDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageDTO) graphql.Marshaler {
+func (ec *executionContext) marshalNImageSrcsetCandidate2ᚖmainᚋinternalᚋsharedᚐImageSrcsetCandidateDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageSrcsetCandidateDTO) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Image(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNImageAction2mainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (enum.Enum[shared.ImageActionMeta], error) {
-	var res enum.Enum[shared.ImageActionMeta]
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._ImageSrcsetCandidate(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNImageAction2mainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v enum.Enum[shared.ImageActionMeta]) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNImageTileSource2mainᚋinternalᚋsharedᚐImageTileSourceDTO(ctx context.Context, sel ast.SelectionSet, v shared.ImageTileSourceDTO) graphql.Marshaler {
+	return ec._ImageTileSource(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNImageFilters2ᚖmainᚋinternalᚋsharedᚐImageFilters(ctx context.Context, sel ast.SelectionSet, v *shared.ImageFilters) graphql.Marshaler {
+func (ec *executionContext) marshalNImageTileSource2ᚖmainᚋinternalᚋsharedᚐImageTileSourceDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageTileSourceDTO) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ImageFilters(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNImageFiltersInput2ᚖmainᚋinternalᚋsharedᚐImageFilters(ctx context.Context, v any) (*shared.ImageFilters, error) {
-	res, err := ec.unmarshalInputImageFiltersInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._ImageTileSource(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
@@ -7605,6 +12102,16 @@ func (ec *executionContext) marshalNMarkImagePayload2ᚖmainᚋinternalᚋinterf
 	return ec._MarkImagePayload(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNMediaType2mainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (enum.Enum[shared.MediaTypeMeta], error) {
+	var res enum.Enum[shared.MediaTypeMeta]
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMediaType2mainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v enum.Enum[shared.MediaTypeMeta]) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNMeta2mainᚋinternalᚋinterfacesᚋgraphqlᚐMeta(ctx context.Context, sel ast.SelectionSet, v Meta) graphql.Marshaler {
 	return ec._Meta(ctx, sel, &v)
 }
@@ -7619,6 +12126,25 @@ func (ec *executionContext) marshalNMeta2ᚖmainᚋinternalᚋinterfacesᚋgraph
 	return ec._Meta(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNPurgeImageCacheInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐPurgeImageCacheInput(ctx context.Context, v any) (PurgeImageCacheInput, error) {
+	res, err := ec.unmarshalInputPurgeImageCacheInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPurgeImageCachePayload2mainᚋinternalᚋinterfacesᚋgraphqlᚐPurgeImageCachePayload(ctx context.Context, sel ast.SelectionSet, v PurgeImageCachePayload) graphql.Marshaler {
+	return ec._PurgeImageCachePayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPurgeImageCachePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐPurgeImageCachePayload(ctx context.Context, sel ast.SelectionSet, v *PurgeImageCachePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PurgeImageCachePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRatingCount2ᚕᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐRatingCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*RatingCount) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -7673,6 +12199,25 @@ func (ec *executionContext) marshalNRatingCount2ᚖmainᚋinternalᚋinterfaces
 	return ec._RatingCount(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNResolveSidecarIssueInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐResolveSidecarIssueInput(ctx context.Context, v any) (ResolveSidecarIssueInput, error) {
+	res, err := ec.unmarshalInputResolveSidecarIssueInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNResolveSidecarIssuePayload2mainᚋinternalᚋinterfacesᚋgraphqlᚐResolveSidecarIssuePayload(ctx context.Context, sel ast.SelectionSet, v ResolveSidecarIssuePayload) graphql.Marshaler {
+	return ec._ResolveSidecarIssuePayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNResolveSidecarIssuePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐResolveSidecarIssuePayload(ctx context.Context, sel ast.SelectionSet, v *ResolveSidecarIssuePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ResolveSidecarIssuePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNSession2mainᚋinternalᚋsharedᚐSessionDTO(ctx context.Context, sel ast.SelectionSet, v shared.SessionDTO) graphql.Marshaler {
 	return ec._Session(ctx, sel, &v)
 }
@@ -7687,6 +12232,30 @@ func (ec *executionContext) marshalNSession2ᚖmainᚋinternalᚋsharedᚐSessio
 	return ec._Session(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSessionLoadProgress2mainᚋinternalᚋsharedᚐSessionLoadProgressDTO(ctx context.Context, sel ast.SelectionSet, v shared.SessionLoadProgressDTO) graphql.Marshaler {
+	return ec._SessionLoadProgress(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSessionLoadProgress2ᚖmainᚋinternalᚋsharedᚐSessionLoadProgressDTO(ctx context.Context, sel ast.SelectionSet, v *shared.SessionLoadProgressDTO) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SessionLoadProgress(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSessionLoadState2mainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (enum.Enum[shared.SessionLoadStateMeta], error) {
+	var res enum.Enum[shared.SessionLoadStateMeta]
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSessionLoadState2mainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v enum.Enum[shared.SessionLoadStateMeta]) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNSessionStats2ᚖmainᚋinternalᚋsharedᚐStatsDTO(ctx context.Context, sel ast.SelectionSet, v *shared.StatsDTO) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -7697,6 +12266,118 @@ func (ec *executionContext) marshalNSessionStats2ᚖmainᚋinternalᚋsharedᚐS
 	return ec._SessionStats(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNSetImageNoteInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageNoteInput(ctx context.Context, v any) (SetImageNoteInput, error) {
+	res, err := ec.unmarshalInputSetImageNoteInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSetImageNotePayload2mainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageNotePayload(ctx context.Context, sel ast.SelectionSet, v SetImageNotePayload) graphql.Marshaler {
+	return ec._SetImageNotePayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSetImageNotePayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageNotePayload(ctx context.Context, sel ast.SelectionSet, v *SetImageNotePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SetImageNotePayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSetImageOrientationInput2mainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageOrientationInput(ctx context.Context, v any) (SetImageOrientationInput, error) {
+	res, err := ec.unmarshalInputSetImageOrientationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSetImageOrientationPayload2mainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageOrientationPayload(ctx context.Context, sel ast.SelectionSet, v SetImageOrientationPayload) graphql.Marshaler {
+	return ec._SetImageOrientationPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSetImageOrientationPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐSetImageOrientationPayload(ctx context.Context, sel ast.SelectionSet, v *SetImageOrientationPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SetImageOrientationPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSidecarIssue2ᚕᚖmainᚋinternalᚋsharedᚐSidecarIssueDTOᚄ(ctx context.Context, sel ast.SelectionSet, v []*shared.SidecarIssueDTO) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSidecarIssue2ᚖmainᚋinternalᚋsharedᚐSidecarIssueDTO(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSidecarIssue2ᚖmainᚋinternalᚋsharedᚐSidecarIssueDTO(ctx context.Context, sel ast.SelectionSet, v *shared.SidecarIssueDTO) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SidecarIssue(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSidecarIssueKind2mainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (enum.Enum[shared.SidecarIssueKindMeta], error) {
+	var res enum.Enum[shared.SidecarIssueKindMeta]
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSidecarIssueKind2mainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v enum.Enum[shared.SidecarIssueKindMeta]) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNSidecarRecoveryAction2mainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (enum.Enum[shared.SidecarRecoveryActionMeta], error) {
+	var res enum.Enum[shared.SidecarRecoveryActionMeta]
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSidecarRecoveryAction2mainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v enum.Enum[shared.SidecarRecoveryActionMeta]) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8134,6 +12815,22 @@ func (ec *executionContext) marshalOID2ᚕmainᚋinternalᚋscalarᚐIDᚄ(ctx c
 	return ret
 }
 
+func (ec *executionContext) unmarshalOID2ᚖmainᚋinternalᚋscalarᚐID(ctx context.Context, v any) (*scalar.ID, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(scalar.ID)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖmainᚋinternalᚋscalarᚐID(ctx context.Context, sel ast.SelectionSet, v *scalar.ID) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOImage2ᚖmainᚋinternalᚋsharedᚐImageDTO(ctx context.Context, sel ast.SelectionSet, v *shared.ImageDTO) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8149,6 +12846,22 @@ func (ec *executionContext) unmarshalOImageFiltersInput2ᚖmainᚋinternalᚋsha
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOImageFormat2ᚖmainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (*enum.Enum[shared.ImageFormatMeta], error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(enum.Enum[shared.ImageFormatMeta])
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOImageFormat2ᚖmainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v *enum.Enum[shared.ImageFormatMeta]) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v any) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8229,6 +12942,18 @@ func (ec *executionContext) marshalOSession2ᚖmainᚋinternalᚋsharedᚐSessio
 	return ec._Session(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalString(v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	if v == nil {
 		return nil, nil
@@ -8283,6 +13008,35 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalOTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	res, err := UnmarshalTime(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	_ = sel
+	res := MarshalTime(v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) unmarshalOURI2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalString(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOURI2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalString(*v)
+	return res
+}
+
 func (ec *executionContext) marshalOUndoPayload2ᚖmainᚋinternalᚋinterfacesᚋgraphqlᚐUndoPayload(ctx context.Context, sel ast.SelectionSet, v *UndoPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8290,6 +13044,22 @@ func (ec *executionContext) marshalOUndoPayload2ᚖmainᚋinternalᚋinterfaces
 	return ec._UndoPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOXMPConflictPolicy2ᚖmainᚋinternalᚋenumᚐEnum(ctx context.Context, v any) (*enum.Enum[shared.XMPConflictPolicyMeta], error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(enum.Enum[shared.XMPConflictPolicyMeta])
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOXMPConflictPolicy2ᚖmainᚋinternalᚋenumᚐEnum(ctx context.Context, sel ast.SelectionSet, v *enum.Enum[shared.XMPConflictPolicyMeta]) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -7,12 +7,15 @@ package graphql
 
 import (
 	"context"
+	"fmt"
 	"main/internal/application/image"
+	"main/internal/enum"
 	"main/internal/shared"
+	"strings"
 )
 
 // URL is the resolver for the url field.
-func (r *imageResolver) URL(ctx context.Context, obj *shared.ImageDTO, width *int, quality *int) (string, error) {
+func (r *imageResolver) URL(ctx context.Context, obj *shared.ImageDTO, width *int, quality *int, format *enum.Enum[shared.ImageFormatMeta]) (string, error) {
 	var opts []image.SignOption
 	if width != nil && *width < obj.Width {
 		opts = append(opts, image.WithWidth(*width))
@@ -20,9 +23,83 @@ func (r *imageResolver) URL(ctx context.Context, obj *shared.ImageDTO, width *in
 	if quality != nil {
 		opts = append(opts, image.WithQuality(*quality))
 	}
+	if format != nil {
+		opts = append(opts, image.WithFormat(*format))
+	}
+	if obj.OrientationOverride != 0 {
+		opts = append(opts, image.WithOrientation(obj.OrientationOverride))
+	}
 	return r.signer.GenerateSignedURL(obj.Path, opts...)
 }
 
+// Srcset is the resolver for the srcset field.
+func (r *imageResolver) Srcset(ctx context.Context, obj *shared.ImageDTO, widths []int, quality *int) (*shared.ImageSrcsetDTO, error) {
+	result := &shared.ImageSrcsetDTO{
+		Sizes: image.SrcsetSizes(obj.Width),
+	}
+	var srcset []string
+	for _, width := range image.SrcsetWidths(widths, obj.Width) {
+		// 与 url 使用相同的参数，原图宽度的地址与不指定 width 时相同
+		url, err := r.URL(ctx, obj, &width, quality, nil)
+		if err != nil {
+			return nil, err
+		}
+		result.Candidates = append(result.Candidates, &shared.ImageSrcsetCandidateDTO{
+			URL:   url,
+			Width: width,
+		})
+		srcset = append(srcset, fmt.Sprintf("%s %dw", url, width))
+	}
+	if len(srcset) == 0 {
+		// 原图尺寸未知时无法缩放，只提供原尺寸的地址
+		url, err := r.URL(ctx, obj, nil, quality, nil)
+		if err != nil {
+			return nil, err
+		}
+		result.Candidates = append(result.Candidates, &shared.ImageSrcsetCandidateDTO{URL: url})
+		srcset = append(srcset, url)
+	}
+	result.Srcset = strings.Join(srcset, ", ")
+	return result, nil
+}
+
+// Placeholder is the resolver for the placeholder field.
+func (r *imageResolver) Placeholder(ctx context.Context, obj *shared.ImageDTO) (*string, error) {
+	return r.app.ImagePlaceholder(ctx, obj)
+}
+
+// TileSource is the resolver for the tileSource field.
+func (r *imageResolver) TileSource(ctx context.Context, obj *shared.ImageDTO) (*shared.ImageTileSourceDTO, error) {
+	var opts []image.SignOption
+	if obj.OrientationOverride != 0 {
+		opts = append(opts, image.WithOrientation(obj.OrientationOverride))
+	}
+	url, err := r.signer.GenerateSignedTileURL(obj.Path, image.TileSize, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &shared.ImageTileSourceDTO{
+		URL:      url,
+		Width:    obj.Width,
+		Height:   obj.Height,
+		TileSize: image.TileSize,
+		Overlap:  image.TileOverlap,
+		MaxLevel: image.TileMaxLevel(obj.Width, obj.Height),
+	}, nil
+}
+
+// VideoURL is the resolver for the videoUrl field.
+func (r *imageResolver) VideoURL(ctx context.Context, obj *shared.ImageDTO) (*string, error) {
+	if obj.MediaType != shared.MediaTypeVideo {
+		return nil, nil
+	}
+	url, err := r.signer.GenerateSignedVideoURL(obj.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &url, nil
+}
+
 // Image returns ImageResolver implementation.
 func (r *Resolver) Image() ImageResolver { return &imageResolver{r} }
 
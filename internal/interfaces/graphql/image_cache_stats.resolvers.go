@@ -0,0 +1,16 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+	"main/internal/shared"
+)
+
+// ImageCacheStats is the resolver for the imageCacheStats field.
+func (r *queryResolver) ImageCacheStats(ctx context.Context) (*shared.ImageCacheStatsDTO, error) {
+	return r.app.ImageCacheStats(ctx)
+}
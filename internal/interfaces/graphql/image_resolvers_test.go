@@ -0,0 +1,81 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"main/internal/infrastructure/urlconv"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageResolver_Srcset(t *testing.T) {
+	rootDir := t.TempDir()
+	path := filepath.Join(rootDir, "a.jpg")
+	require.NoError(t, os.WriteFile(path, []byte("test"), 0o644))
+	r := &imageResolver{NewResolver(nil, rootDir, urlconv.NewSigner("secret", rootDir), "")}
+
+	quality := 80
+	testCases := []struct {
+		name       string
+		width      int
+		widths     []int
+		quality    *int
+		wantWidths []int
+	}{
+		{"default widths clamped to original", 1000, nil, nil, []int{320, 640, 960, 1000}},
+		{"sort and dedup", 1000, []int{800, 400, 400, 2000}, nil, []int{400, 800, 1000}},
+		{"with quality", 1000, []int{500}, &quality, []int{500}},
+		{"unknown original width", 0, []int{400}, nil, nil},
+		{"only invalid widths", 1000, []int{0, -1}, nil, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &shared.ImageDTO{Path: path, Width: tc.width}
+			got, err := r.Srcset(context.Background(), obj, tc.widths, tc.quality)
+			require.NoError(t, err)
+
+			originalURL, err := r.URL(context.Background(), obj, nil, tc.quality, nil)
+			require.NoError(t, err)
+
+			if tc.wantWidths == nil {
+				// 无法缩放时只提供原尺寸的地址
+				require.Len(t, got.Candidates, 1)
+				assert.Equal(t, originalURL, got.Candidates[0].URL)
+				assert.Zero(t, got.Candidates[0].Width)
+				assert.Equal(t, originalURL, got.Srcset)
+				return
+			}
+
+			var widths []int
+			var srcset []string
+			for _, c := range got.Candidates {
+				widths = append(widths, c.Width)
+				srcset = append(srcset, fmt.Sprintf("%s %dw", c.URL, c.Width))
+
+				u, err := url.Parse(c.URL)
+				require.NoError(t, err)
+				if c.Width >= tc.width {
+					// 原图宽度的地址与不指定 width 时相同，可以命中缓存
+					assert.Equal(t, originalURL, c.URL)
+				} else {
+					assert.Equal(t, strconv.Itoa(c.Width), u.Query().Get("w"))
+				}
+				if tc.quality != nil {
+					assert.Equal(t, strconv.Itoa(*tc.quality), u.Query().Get("q"))
+				}
+			}
+			assert.Equal(t, tc.wantWidths, widths)
+			assert.Equal(t, strings.Join(srcset, ", "), got.Srcset)
+			assert.Equal(t, "(max-width: 1000px) 100vw, 1000px", got.Sizes)
+		})
+	}
+}
@@ -11,10 +11,22 @@ import (
 type Node interface {
 }
 
+type CancelSessionCreationInput struct {
+	SessionID        scalar.ID `json:"sessionId"`
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+}
+
+type CancelSessionCreationPayload struct {
+	Session          *shared.SessionDTO `json:"session"`
+	ClientMutationID *string            `json:"clientMutationId,omitempty"`
+}
+
 type CommitChangesInput struct {
-	SessionID        scalar.ID            `json:"sessionId"`
-	WriteActions     *shared.WriteActions `json:"writeActions"`
-	ClientMutationID *string              `json:"clientMutationId,omitempty"`
+	SessionID    scalar.ID            `json:"sessionId"`
+	WriteActions *shared.WriteActions `json:"writeActions"`
+	// 默认为 SKIP
+	ConflictPolicy   *enum.Enum[shared.XMPConflictPolicyMeta] `json:"conflictPolicy,omitempty"`
+	ClientMutationID *string                                  `json:"clientMutationId,omitempty"`
 }
 
 type CommitChangesPayload struct {
@@ -56,6 +68,19 @@ type Meta struct {
 type Mutation struct {
 }
 
+type PurgeImageCacheInput struct {
+	// 源图片相对于根目录的路径，为空时清除全部缓存
+	Path             *string `json:"path,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+type PurgeImageCachePayload struct {
+	// 删除的缓存项数量
+	Count            int                        `json:"count"`
+	Stats            *shared.ImageCacheStatsDTO `json:"stats"`
+	ClientMutationID *string                    `json:"clientMutationId,omitempty"`
+}
+
 type Query struct {
 }
 
@@ -64,6 +89,44 @@ type RatingCount struct {
 	Count  int `json:"count"`
 }
 
+type ResolveSidecarIssueInput struct {
+	ID               scalar.ID                                   `json:"id"`
+	Action           enum.Enum[shared.SidecarRecoveryActionMeta] `json:"action"`
+	ClientMutationID *string                                     `json:"clientMutationId,omitempty"`
+}
+
+type ResolveSidecarIssuePayload struct {
+	// 使用 SALVAGE 时提取到的评分
+	Rating           *int    `json:"rating,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+type SetImageNoteInput struct {
+	SessionID scalar.ID `json:"sessionId"`
+	ImageID   scalar.ID `json:"imageId"`
+	// 空字符串表示清除备注
+	Note             string  `json:"note"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+type SetImageNotePayload struct {
+	Session          *shared.SessionDTO `json:"session"`
+	ClientMutationID *string            `json:"clientMutationId,omitempty"`
+}
+
+type SetImageOrientationInput struct {
+	SessionID scalar.ID `json:"sessionId"`
+	ImageID   scalar.ID `json:"imageId"`
+	// EXIF 方向（1-8），null 表示清除，恢复使用原图中的方向
+	Orientation      *int    `json:"orientation,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+type SetImageOrientationPayload struct {
+	Session          *shared.SessionDTO `json:"session"`
+	ClientMutationID *string            `json:"clientMutationId,omitempty"`
+}
+
 type Subscription struct {
 }
 
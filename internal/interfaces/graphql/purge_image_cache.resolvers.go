@@ -0,0 +1,33 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+)
+
+// PurgeImageCache is the resolver for the purgeImageCache field.
+func (r *mutationResolver) PurgeImageCache(ctx context.Context, input PurgeImageCacheInput) (*PurgeImageCachePayload, error) {
+	var path string
+	if input.Path != nil {
+		path = *input.Path
+	}
+	count, err := r.app.PurgeImageCache(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := r.app.ImageCacheStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PurgeImageCachePayload{
+		Count:            count,
+		Stats:            stats,
+		ClientMutationID: input.ClientMutationID,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+)
+
+// ResolveSidecarIssue is the resolver for the resolveSidecarIssue field.
+func (r *mutationResolver) ResolveSidecarIssue(ctx context.Context, input ResolveSidecarIssueInput) (*ResolveSidecarIssuePayload, error) {
+	rating, err := r.app.ResolveSidecarIssue(ctx, input.ID, input.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResolveSidecarIssuePayload{
+		Rating:           rating,
+		ClientMutationID: input.ClientMutationID,
+	}, nil
+}
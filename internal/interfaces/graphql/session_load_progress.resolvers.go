@@ -0,0 +1,17 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+	"main/internal/scalar"
+	"main/internal/shared"
+)
+
+// SessionLoadProgress is the resolver for the sessionLoadProgress field.
+func (r *subscriptionResolver) SessionLoadProgress(ctx context.Context, id scalar.ID) (<-chan *shared.SessionLoadProgressDTO, error) {
+	return SubscriptionFromSeq(ctx, r.app.SubscribeSessionLoadProgress(ctx, id))
+}
@@ -0,0 +1,33 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+)
+
+// SetImageNote is the resolver for the setImageNote field.
+func (r *mutationResolver) SetImageNote(ctx context.Context, input SetImageNoteInput) (*SetImageNotePayload, error) {
+	err := r.app.SetImageNote(
+		ctx,
+		input.SessionID,
+		input.ImageID,
+		input.Note,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := r.app.Session(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetImageNotePayload{
+		Session:          sess,
+		ClientMutationID: input.ClientMutationID,
+	}, nil
+}
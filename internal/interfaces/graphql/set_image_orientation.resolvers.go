@@ -0,0 +1,37 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+)
+
+// SetImageOrientation is the resolver for the setImageOrientation field.
+func (r *mutationResolver) SetImageOrientation(ctx context.Context, input SetImageOrientationInput) (*SetImageOrientationPayload, error) {
+	var orientation int
+	if input.Orientation != nil {
+		orientation = *input.Orientation
+	}
+	err := r.app.SetImageOrientation(
+		ctx,
+		input.SessionID,
+		input.ImageID,
+		orientation,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := r.app.Session(ctx, input.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetImageOrientationPayload{
+		Session:          sess,
+		ClientMutationID: input.ClientMutationID,
+	}, nil
+}
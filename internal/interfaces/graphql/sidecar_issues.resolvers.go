@@ -0,0 +1,22 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+	"main/internal/domain/directory"
+	"main/internal/scalar"
+	"main/internal/shared"
+)
+
+// SidecarIssues is the resolver for the sidecarIssues field.
+func (r *queryResolver) SidecarIssues(ctx context.Context, directoryID *scalar.ID) ([]*shared.SidecarIssueDTO, error) {
+	id := directory.EncodeID(".")
+	if directoryID != nil {
+		id = *directoryID
+	}
+	return r.app.SidecarIssues(ctx, id)
+}
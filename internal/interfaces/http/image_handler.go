@@ -3,17 +3,24 @@ package http
 import (
 	"context"
 	"errors"
+	"io/fs"
+	"mime"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
+	appimage "main/internal/application/image"
+	"main/internal/enum"
 	"main/internal/infrastructure/urlconv"
+	"main/internal/shared"
 
 	"go.uber.org/zap"
 )
 
 type ImageProcessor interface {
-	Process(ctx context.Context, path string, width int, quality int) (string, error)
+	Process(ctx context.Context, path string, width int, quality int, format shared.ImageFormat) (string, error)
 }
 
 func handleImage(
@@ -21,8 +28,18 @@ func handleImage(
 	signer *urlconv.Signer,
 	imageProcessor ImageProcessor,
 	absRootDir string,
+	previewFormats []shared.ImageFormat,
+	observeFormat func(path string, format shared.ImageFormat),
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		formatStr := query.Get("f")
+		raw := query.Has("raw")
+		if !raw && formatStr == "" {
+			// 输出格式由 Accept 决定，共享缓存需要按 Accept 区分
+			w.Header().Add("Vary", "Accept")
+		}
+
 		// 为不支持 Cache-Control: immutable 的浏览器(Chrome) 提供 304 响应
 		// 缓存条目时会规范要求按 URL 隔离，所以 ETag 不需全局唯一，不考虑错误客户端
 		const etag = `"immutable"`
@@ -31,11 +48,9 @@ func handleImage(
 			return
 		}
 
-		query := r.URL.Query()
 		relativePath := query.Get("path")
 		widthStr := query.Get("w")
 		qualityStr := query.Get("q")
-		raw := query.Has("raw")
 
 		err := signer.ValidateRequestFromValues(query)
 		if err != nil {
@@ -44,7 +59,7 @@ func handleImage(
 		}
 
 		absPath := filepath.Join(absRootDir, relativePath)
-		targetPath := absPath
+		var processed *os.File
 		shouldSetHeaders := raw
 
 		if !raw {
@@ -62,7 +77,24 @@ func handleImage(
 				}
 			}
 
-			processedPath, err := imageProcessor.Process(r.Context(), absPath, width, quality)
+			var format shared.ImageFormat
+			if formatStr != "" {
+				format, err = enum.Parse[shared.ImageFormatMeta](formatStr)
+				if err != nil {
+					http.Error(w, "invalid format: "+formatStr, http.StatusBadRequest)
+					return
+				}
+			} else {
+				format = negotiateImageFormat(r.Header.Get("Accept"), previewFormats)
+			}
+			if observeFormat != nil {
+				observeFormat(absPath, format)
+			}
+
+			ctx := processContext(r)
+			processed, err = openProcessed(func() (string, error) {
+				return imageProcessor.Process(ctx, absPath, width, quality, format)
+			})
 			if errors.Is(err, context.Canceled) {
 				http.Error(w, "request canceled", http.StatusRequestTimeout)
 				return
@@ -70,8 +102,11 @@ func handleImage(
 			if err != nil {
 				logger.Error("process image", zap.Error(err))
 			} else {
-				targetPath = processedPath
+				defer processed.Close()
 				shouldSetHeaders = true
+				if contentType := mime.TypeByExtension(filepath.Ext(processed.Name())); contentType != "" {
+					w.Header().Set("Content-Type", contentType)
+				}
 			}
 		}
 
@@ -79,6 +114,89 @@ func handleImage(
 			w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
 			w.Header().Set("ETag", etag)
 		}
-		http.ServeFile(w, r, targetPath)
+		if processed != nil {
+			serveOpenFile(w, r, processed)
+			return
+		}
+		http.ServeFile(w, r, absPath)
+	}
+}
+
+// openProcessed 处理并打开结果文件，结果在返回后被缓存淘汰时重新处理一次
+//
+// 打开的文件在发送完成前不会因淘汰而无法读取
+func openProcessed(process func() (string, error)) (*os.File, error) {
+	for attempt := 0; ; attempt++ {
+		path, err := process()
+		if err == nil {
+			var f *os.File
+			if f, err = os.Open(path); err == nil {
+				return f, nil
+			}
+		}
+		if attempt > 0 || !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+}
+
+// serveOpenFile 发送已打开的文件，支持 Range 和条件请求
+func serveOpenFile(w http.ResponseWriter, r *http.Request, f *os.File) {
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, "failed to read file", http.StatusInternalServerError)
+		return
+	}
+	http.ServeContent(w, r, info.Name(), info.ModTime(), f)
+}
+
+// processContext 返回处理请求使用的 context，包含请求中的优先级和签名中指定的方向
+func processContext(r *http.Request) context.Context {
+	ctx := appimage.ContextWithPriority(r.Context(), requestPriority(r))
+	if o, err := strconv.Atoi(r.URL.Query().Get("o")); err == nil {
+		ctx = appimage.ContextWithOrientation(ctx, o)
+	}
+	return ctx
+}
+
+const (
+	// priorityHeader 指定处理优先级，用于可以设置请求头的客户端
+	priorityHeader = "X-Image-Priority"
+	// priorityParam 指定处理优先级，用于无法设置请求头的 <img> 预加载
+	//
+	// 优先级不在签名和缓存键中，不同优先级的请求共享缓存
+	priorityParam = "p"
+)
+
+// requestPriority 返回请求的处理优先级，数值越小越优先，请求头优先于地址参数
+func requestPriority(r *http.Request) int {
+	for _, value := range []string{r.Header.Get(priorityHeader), r.URL.Query().Get(priorityParam)} {
+		if priority, err := strconv.Atoi(value); err == nil {
+			return max(appimage.PriorityCurrent, priority)
+		}
+	}
+	return appimage.PriorityCurrent
+}
+
+// negotiateImageFormat 按服务端偏好顺序选择客户端明确支持的格式
+//
+// 旧设备通常只发送 `*/*`，此时无法判断是否支持新格式，使用最后一个格式（通常为 JPEG）兜底
+func negotiateImageFormat(accept string, formats []shared.ImageFormat) shared.ImageFormat {
+	if len(formats) == 0 {
+		return shared.ImageFormatJPEG
+	}
+	accepted := make(map[string]bool)
+	for part := range strings.SplitSeq(accept, ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		if strings.ReplaceAll(params, " ", "") == "q=0" {
+			continue
+		}
+		accepted[strings.ToLower(strings.TrimSpace(mediaType))] = true
+	}
+	for _, format := range formats {
+		if accepted[format.Meta().ContentType] {
+			return format
+		}
 	}
+	return formats[len(formats)-1]
 }
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appimage "main/internal/application/image"
+	"main/internal/infrastructure/localfs"
+	"main/internal/infrastructure/stdimage"
+	"main/internal/infrastructure/urlconv"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNegotiateImageFormat(t *testing.T) {
+	formats := []shared.ImageFormat{shared.ImageFormatAVIF, shared.ImageFormatWebP, shared.ImageFormatJPEG}
+
+	testCases := []struct {
+		name   string
+		accept string
+		want   shared.ImageFormat
+	}{
+		{"modern browser", "image/avif,image/webp,image/apng,image/*,*/*;q=0.8", shared.ImageFormatAVIF},
+		{"webp only", "image/webp,*/*", shared.ImageFormatWebP},
+		{"avif refused", "image/avif;q=0, image/webp", shared.ImageFormatWebP},
+		{"wildcard", "*/*", shared.ImageFormatJPEG},
+		{"empty", "", shared.ImageFormatJPEG},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, negotiateImageFormat(tc.accept, formats))
+		})
+	}
+}
+
+func TestRequestPriority(t *testing.T) {
+	testCases := []struct {
+		name   string
+		target string
+		header string
+		want   int
+	}{
+		{name: "header", target: "/image", header: "100", want: appimage.PriorityBackground},
+		{name: "query parameter", target: "/image?p=3", want: 3},
+		{name: "header takes precedence", target: "/image?p=3", header: "100", want: appimage.PriorityBackground},
+		{name: "invalid header falls back to query parameter", target: "/image?p=3", header: "x", want: 3},
+		{name: "negative", target: "/image?p=-1", header: "-1", want: appimage.PriorityCurrent},
+		{name: "default", target: "/image", want: appimage.PriorityCurrent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			if tc.header != "" {
+				r.Header.Set(priorityHeader, tc.header)
+			}
+			assert.Equal(t, tc.want, requestPriority(r))
+		})
+	}
+}
+
+func TestOpenProcessed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.jpg")
+	var calls int
+	f, err := openProcessed(func() (string, error) {
+		calls++
+		if calls == 2 {
+			// 第一次返回的结果在打开前被淘汰，重新处理
+			require.NoError(t, os.WriteFile(path, []byte("x"), 0644))
+		}
+		return path, nil
+	})
+	require.NoError(t, err)
+	defer f.Close()
+	assert.Equal(t, 2, calls)
+
+	// 只重试一次
+	calls = 0
+	_, err = openProcessed(func() (string, error) {
+		calls++
+		return path + ".missing", nil
+	})
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	_, err = openProcessed(func() (string, error) {
+		calls++
+		return "", errors.New("failed")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+// avifEncoder 模拟 ImageMagick 输出只有 AVIF 文件头的文件
+type avifEncoder struct {
+	dir string
+}
+
+func (e *avifEncoder) Process(ctx context.Context, srcPath string, width, quality int, format shared.ImageFormat) (string, error) {
+	path := filepath.Join(e.dir, "out"+format.Meta().Extension)
+	return path, os.WriteFile(path, []byte("\x00\x00\x00\x1cftypavif"), 0644)
+}
+
+func (e *avifEncoder) Meta(ctx context.Context, srcPath string) (*shared.ImageMeta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestHandleImage_NegotiatedFormat(t *testing.T) {
+	rootDir := t.TempDir()
+	f, err := os.Create(filepath.Join(rootDir, "a.png"))
+	require.NoError(t, err)
+	require.NoError(t, png.Encode(f, image.NewRGBA(image.Rect(0, 0, 10, 6))))
+	require.NoError(t, f.Close())
+
+	cache, err := localfs.NewImageCache(t.TempDir(), 1<<20, zap.NewNop())
+	require.NoError(t, err)
+	signer := urlconv.NewSigner("secret", rootDir)
+	processor := stdimage.NewHybridProcessor(
+		stdimage.NewProcessor(cache, localfs.NewPathCacheKeyer(), 1),
+		&avifEncoder{dir: t.TempDir()}, nil, shared.NewDefaultFormatRegistry(),
+	)
+	handler := handleImage(zap.NewNop(), signer, processor, rootDir,
+		[]shared.ImageFormat{shared.ImageFormatAVIF, shared.ImageFormatWebP, shared.ImageFormatJPEG}, nil)
+
+	url, err := signer.GenerateSignedURL(filepath.Join(rootDir, "a.png"), appimage.WithWidth(5))
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodGet, "/"+url, nil)
+	r.Header.Set("Accept", "image/avif,image/webp,*/*")
+	w := httptest.NewRecorder()
+	handler(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image/avif", w.Header().Get("Content-Type"))
+	assert.Equal(t, "ftypavif", w.Body.String()[4:12])
+	assert.Contains(t, w.Header().Values("Vary"), "Accept")
+}
+
+// priorityRecorder 记录处理请求的优先级和结果
+type priorityRecorder struct {
+	next       ImageProcessor
+	priorities []int
+	paths      []string
+}
+
+func (p *priorityRecorder) Process(ctx context.Context, path string, width, quality int, format shared.ImageFormat) (string, error) {
+	p.priorities = append(p.priorities, appimage.PriorityFromContext(ctx))
+	out, err := p.next.Process(ctx, path, width, quality, format)
+	p.paths = append(p.paths, out)
+	return out, err
+}
+
+func TestHandleImage_PriorityParam(t *testing.T) {
+	rootDir := t.TempDir()
+	f, err := os.Create(filepath.Join(rootDir, "a.png"))
+	require.NoError(t, err)
+	require.NoError(t, png.Encode(f, image.NewRGBA(image.Rect(0, 0, 10, 6))))
+	require.NoError(t, f.Close())
+
+	cache, err := localfs.NewImageCache(t.TempDir(), 1<<20, zap.NewNop())
+	require.NoError(t, err)
+	signer := urlconv.NewSigner("secret", rootDir)
+	recorder := &priorityRecorder{next: stdimage.NewHybridProcessor(
+		stdimage.NewProcessor(cache, localfs.NewPathCacheKeyer(), 1),
+		&avifEncoder{dir: t.TempDir()}, nil, shared.NewDefaultFormatRegistry(),
+	)}
+	handler := handleImage(zap.NewNop(), signer, recorder, rootDir, []shared.ImageFormat{shared.ImageFormatJPEG}, nil)
+
+	url, err := signer.GenerateSignedURL(filepath.Join(rootDir, "a.png"), appimage.WithWidth(5))
+	require.NoError(t, err)
+	// 前端预加载时在签名地址后追加优先级，与正在查看的图片共享缓存
+	for _, target := range []string{"/" + url + "&p=3", "/" + url} {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, target, nil))
+		require.Equal(t, http.StatusOK, w.Code, target)
+	}
+	assert.Equal(t, []int{3, appimage.PriorityCurrent}, recorder.priorities)
+	require.Len(t, recorder.paths, 2)
+	assert.Equal(t, recorder.paths[0], recorder.paths[1], "Priority should not be part of the cache key")
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"main/internal/infrastructure/urlconv"
+	"main/internal/shared"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
@@ -15,33 +16,58 @@ type Server struct {
 	logger         *zap.Logger
 	signer         *urlconv.Signer
 	imageProcessor ImageProcessor
+	tileProcessor  TileProcessor
 	graphqlHandler http.Handler
 	playground     http.Handler
 	absRootDir     string
 	frontendDir    string
 	corsHosts      []string
+	previewFormats []shared.ImageFormat
+	sourceFormats  *shared.FormatRegistry
+	observeFormat  func(path string, format shared.ImageFormat)
+}
+
+type ServerOption func(*Server)
+
+// WithFormatObserver 在请求预览图时以图片的绝对路径和输出格式调用 observe，
+// 用于按客户端实际使用的格式预热
+func WithFormatObserver(observe func(path string, format shared.ImageFormat)) ServerOption {
+	return func(s *Server) {
+		s.observeFormat = observe
+	}
 }
 
 func NewServer(
 	logger *zap.Logger,
 	signer *urlconv.Signer,
 	imageProcessor ImageProcessor,
+	tileProcessor TileProcessor,
 	graphqlHandler http.Handler,
 	playground http.Handler,
 	absRootDir string,
 	frontendDir string,
 	corsHosts []string,
+	previewFormats []shared.ImageFormat,
+	sourceFormats *shared.FormatRegistry,
+	options ...ServerOption,
 ) *Server {
-	return &Server{
+	s := &Server{
 		logger:         logger,
 		signer:         signer,
 		imageProcessor: imageProcessor,
+		tileProcessor:  tileProcessor,
 		graphqlHandler: graphqlHandler,
 		playground:     playground,
 		absRootDir:     absRootDir,
 		frontendDir:    frontendDir,
 		corsHosts:      corsHosts,
+		previewFormats: previewFormats,
+		sourceFormats:  sourceFormats,
+	}
+	for _, option := range options {
+		option(s)
 	}
+	return s
 }
 
 func (s *Server) Serve(addr string) error {
@@ -58,7 +84,9 @@ func (s *Server) Serve(addr string) error {
 		s.graphqlHandler.ServeHTTP(w, r)
 	})
 
-	r.HandleFunc("/image", handleImage(s.logger, s.signer, s.imageProcessor, s.absRootDir))
+	r.HandleFunc("/image", handleImage(s.logger, s.signer, s.imageProcessor, s.absRootDir, s.previewFormats, s.observeFormat))
+	r.HandleFunc("/tile", handleTile(s.logger, s.signer, s.tileProcessor, s.absRootDir))
+	r.HandleFunc("/video", handleVideo(s.signer, s.sourceFormats, s.absRootDir))
 
 	addStaticRoutes(r, s.frontendDir)
 
@@ -67,7 +95,7 @@ func (s *Server) Serve(addr string) error {
 			return isOriginAllowed(origin, "", s.corsHosts)
 		},
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders:   []string{"Content-Type", "Authorization", "X-Apollo-Tracing", "Apollo-Query-Plan"},
+		AllowedHeaders:   []string{"Content-Type", "Range", "Authorization", "X-Apollo-Tracing", "Apollo-Query-Plan", priorityHeader},
 		AllowCredentials: true,
 	}).Handler(r)
 
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	appimage "main/internal/application/image"
+	"main/internal/infrastructure/urlconv"
+
+	"go.uber.org/zap"
+)
+
+type TileProcessor interface {
+	Tile(ctx context.Context, path string, tileSize, level, col, row int) (string, error)
+}
+
+// handleTile 提供 Deep Zoom 瓦片
+//
+// 签名覆盖图片和瓦片尺寸，层级（l）和行列（x、y）由客户端追加
+func handleTile(
+	logger *zap.Logger,
+	signer *urlconv.Signer,
+	tileProcessor TileProcessor,
+	absRootDir string,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const etag = `"immutable"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		query := r.URL.Query()
+		if err := signer.ValidateRequestFromValues(query); err != nil {
+			http.Error(w, "invalid signature: "+err.Error(), http.StatusForbidden)
+			return
+		}
+
+		var values [4]int
+		for i, key := range []string{"ts", "l", "x", "y"} {
+			v, err := strconv.Atoi(query.Get(key))
+			if err != nil {
+				http.Error(w, "invalid parameter: "+key, http.StatusBadRequest)
+				return
+			}
+			values[i] = v
+		}
+		tileSize, level, col, row := values[0], values[1], values[2], values[3]
+
+		absPath := filepath.Join(absRootDir, query.Get("path"))
+		ctx := processContext(r)
+		tile, err := openProcessed(func() (string, error) {
+			return tileProcessor.Tile(ctx, absPath, tileSize, level, col, row)
+		})
+		if errors.Is(err, context.Canceled) {
+			http.Error(w, "request canceled", http.StatusRequestTimeout)
+			return
+		}
+		if errors.Is(err, appimage.ErrTileNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			logger.Error("process tile", zap.Error(err))
+			http.Error(w, "failed to process tile", http.StatusInternalServerError)
+			return
+		}
+
+		defer tile.Close()
+
+		if contentType := mime.TypeByExtension(filepath.Ext(tile.Name())); contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("ETag", etag)
+		serveOpenFile(w, r, tile)
+	}
+}
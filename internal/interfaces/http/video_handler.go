@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+	"path/filepath"
+
+	"main/internal/infrastructure/urlconv"
+	"main/internal/shared"
+)
+
+// handleVideo 提供视频原文件，支持 Range 请求以便播放器拖动进度
+func handleVideo(
+	signer *urlconv.Signer,
+	formats *shared.FormatRegistry,
+	absRootDir string,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 签名包含文件修改时间和大小，文件变化后地址也会变化
+		const etag = `"immutable"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		query := r.URL.Query()
+		if err := signer.ValidateRequestFromValues(query); err != nil {
+			http.Error(w, "invalid signature: "+err.Error(), http.StatusForbidden)
+			return
+		}
+
+		relativePath := query.Get("path")
+		format, ok := formats.Lookup(relativePath)
+		if !ok || !format.Meta().Video {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", format.Meta().ContentType)
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("ETag", etag)
+		// ServeFile 处理 Range 和 If-Range
+		http.ServeFile(w, r, filepath.Join(absRootDir, relativePath))
+	}
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"main/internal/infrastructure/urlconv"
+	"main/internal/shared"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleVideo(t *testing.T) {
+	rootDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "a.mp4"), []byte("0123456789"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "b.jpg"), []byte("0123456789"), 0644))
+
+	signer := urlconv.NewSigner("test-secret-key", rootDir)
+	handler := handleVideo(signer, shared.NewDefaultFormatRegistry(), rootDir)
+
+	videoURL, err := signer.GenerateSignedVideoURL("a.mp4")
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodGet, "/"+videoURL, nil)
+	r.Header.Set("Range", "bytes=2-5")
+	w := httptest.NewRecorder()
+	handler(w, r)
+	resp := w.Result()
+	assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+	assert.Equal(t, "video/mp4", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "bytes 2-5/10", resp.Header.Get("Content-Range"))
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "2345", string(body))
+
+	imageURL, err := signer.GenerateSignedVideoURL("b.jpg")
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/"+imageURL, nil))
+	assert.Equal(t, http.StatusNotFound, w.Code, "Only videos should be served")
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/video?path=a.mp4&t=0&s=10&sig=invalid", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -12,6 +12,11 @@ type FileChangedEvent struct {
 	RelPath     string    // 文件路径
 	Action      FileAction
 	OccurredAt  time.Time
+
+	// 重命名或移动前的目录ID和路径，只有 Action 为 RENAME 时可能有值，
+	// 为空表示只知道旧路径（RelPath），等同于删除
+	OldDirectoryID scalar.ID
+	OldRelPath     string
 }
 
 // DirectoryFilters 目录查询过滤器
@@ -46,6 +51,57 @@ type ImageDTO struct {
 	Width         int
 	Height        int
 	XMPExists     bool
+	Note          string
+	History       []*ImageHistoryEventDTO
+	XMPError      string
+	MediaType     MediaType
+	Duration      *scalar.Duration // 视频时长，图片为 nil
+	Orientation   int              // 生效的 EXIF 方向，1-8
+	// OrientationOverride 是 XMP 边车中设置的方向，0 表示未设置，需要随预览地址传递给处理器
+	OrientationOverride int
+}
+
+// ImageHistoryEventDTO 图片编辑历史记录数据传输对象
+type ImageHistoryEventDTO struct {
+	Action        string
+	Rating        *int
+	Timestamp     time.Time
+	SoftwareAgent string
+	SessionID     *scalar.ID
+}
+
+// ImageTileSourceDTO Deep Zoom 瓦片源数据传输对象
+type ImageTileSourceDTO struct {
+	URL      string
+	Width    int
+	Height   int
+	TileSize int
+	Overlap  int
+	MaxLevel int
+}
+
+// ImageSrcsetDTO 响应式图片地址数据传输对象
+type ImageSrcsetDTO struct {
+	Srcset     string
+	Sizes      string
+	Candidates []*ImageSrcsetCandidateDTO
+}
+
+// ImageSrcsetCandidateDTO srcset 中的单个地址
+type ImageSrcsetCandidateDTO struct {
+	URL   string
+	Width int
+}
+
+// SidecarIssueDTO sidecar 问题数据传输对象
+type SidecarIssueDTO struct {
+	ID                scalar.ID
+	Kind              SidecarIssueKind
+	Path              string
+	ImagePath         string
+	ModTime           time.Time
+	Error             string
+	SalvageableRating *int
 }
 
 // SessionDTO 会话数据传输对象
@@ -62,6 +118,16 @@ type SessionDTO struct {
 	CurrentIndex int
 	CurrentSize  int
 	CurrentImage *ImageDTO
+	LoadProgress *SessionLoadProgressDTO
+}
+
+// SessionLoadProgressDTO 会话加载进度
+type SessionLoadProgressDTO struct {
+	SessionID      scalar.ID
+	State          SessionLoadState
+	FilesSeen      int
+	ImagesAccepted int
+	Error          *string
 }
 
 // StatsDTO 会话统计数据
@@ -76,13 +142,25 @@ type StatsDTO struct {
 
 // WriteActions 写入操作配置
 type WriteActions struct {
-	KeepRating   int
-	ShelveRating int
-	RejectRating int
+	KeepRating     int
+	ShelveRating   int
+	RejectRating   int
+	ConflictPolicy XMPConflictPolicy // sidecar 被外部修改时的处理方式，零值视为 SKIP
 }
 
 // ImageMeta 图片元数据
 type ImageMeta struct {
-	Width  int
-	Height int
+	Width       int           // 按 Orientation 旋转后的显示宽度
+	Height      int           // 按 Orientation 旋转后的显示高度
+	Orientation int           // 原图中的 EXIF/XMP 方向，0 表示未知或未设置
+	Duration    time.Duration // 视频时长
+}
+
+// ImageCacheStatsDTO 图片缓存统计
+type ImageCacheStatsDTO struct {
+	Hits    int64 // 命中次数
+	Misses  int64 // 未命中次数
+	Count   int   // 缓存项数量
+	Size    int64 // 总大小（字节）
+	MaxSize int64 // 大小上限（字节），不大于 0 表示不限制
 }
@@ -26,3 +26,128 @@ var (
 )
 
 type FileAction = enum.Enum[FileActionMeta]
+
+type XMPConflictPolicyMeta struct{}
+
+var xmpConflictPolicy = enum.New[XMPConflictPolicyMeta]()
+var (
+	// XMPConflictPolicySkip 跳过被外部修改的 sidecar 并报告冲突
+	XMPConflictPolicySkip = xmpConflictPolicy.Define("SKIP")
+	// XMPConflictPolicyOverwrite 忽略外部修改直接写入
+	XMPConflictPolicyOverwrite = xmpConflictPolicy.Define("OVERWRITE")
+	// XMPConflictPolicyMerge 合并外部修改，只有同一字段被双方改为不同值时才报告冲突
+	XMPConflictPolicyMerge = xmpConflictPolicy.Define("MERGE")
+)
+
+type XMPConflictPolicy = enum.Enum[XMPConflictPolicyMeta]
+
+type SidecarIssueKindMeta struct{}
+
+var sidecarIssueKind = enum.New[SidecarIssueKindMeta]()
+var (
+	// SidecarIssueKindUnparseable sidecar 无法解析
+	SidecarIssueKindUnparseable = sidecarIssueKind.Define("UNPARSEABLE")
+	// SidecarIssueKindBackup 原子写入中断后遗留的 `~` 备份
+	SidecarIssueKindBackup = sidecarIssueKind.Define("BACKUP")
+	// SidecarIssueKindBrokenBackup 写入时发现 sidecar 损坏而另存的 `.broken` 文件
+	SidecarIssueKindBrokenBackup = sidecarIssueKind.Define("BROKEN_BACKUP")
+)
+
+type SidecarIssueKind = enum.Enum[SidecarIssueKindMeta]
+
+type SidecarRecoveryActionMeta struct{}
+
+var sidecarRecoveryAction = enum.New[SidecarRecoveryActionMeta]()
+var (
+	// SidecarRecoveryActionRestore 用备份替换 sidecar
+	SidecarRecoveryActionRestore = sidecarRecoveryAction.Define("RESTORE")
+	// SidecarRecoveryActionSalvage 宽松地提取评分并写入 sidecar
+	SidecarRecoveryActionSalvage = sidecarRecoveryAction.Define("SALVAGE")
+	// SidecarRecoveryActionDiscard 删除问题文件
+	SidecarRecoveryActionDiscard = sidecarRecoveryAction.Define("DISCARD")
+)
+
+type SidecarRecoveryAction = enum.Enum[SidecarRecoveryActionMeta]
+
+type ImageFormatMeta struct {
+	ContentType string
+	Extension   string
+}
+
+var imageFormat = enum.New[ImageFormatMeta]()
+var (
+	ImageFormatAVIF = imageFormat.Define("AVIF", ImageFormatMeta{ContentType: "image/avif", Extension: ".avif"})
+	ImageFormatWebP = imageFormat.Define("WEBP", ImageFormatMeta{ContentType: "image/webp", Extension: ".webp"})
+	ImageFormatJPEG = imageFormat.Define("JPEG", ImageFormatMeta{ContentType: "image/jpeg", Extension: ".jpg"})
+	// ImageFormatPNG 仅用于输出带透明度的预览，不参与协商
+	ImageFormatPNG = imageFormat.Define("PNG", ImageFormatMeta{ContentType: "image/png", Extension: ".png"})
+)
+
+type ImageFormat = enum.Enum[ImageFormatMeta]
+
+type SourceFormatMeta struct {
+	Extensions  []string // 小写扩展名，包含点
+	ContentType string
+	Native      bool // 可以使用纯 Go 解码，否则需要 ImageMagick
+	Animated    bool // 可能包含动画
+	Video       bool // 视频，使用外部命令读取信息和封面
+}
+
+var sourceFormat = enum.New[SourceFormatMeta]()
+var (
+	SourceFormatJPEG = sourceFormat.Define("JPEG", SourceFormatMeta{Extensions: []string{".jpg", ".jpeg"}, ContentType: "image/jpeg", Native: true})
+	SourceFormatPNG  = sourceFormat.Define("PNG", SourceFormatMeta{Extensions: []string{".png"}, ContentType: "image/png", Native: true})
+	SourceFormatWebP = sourceFormat.Define("WEBP", SourceFormatMeta{Extensions: []string{".webp"}, ContentType: "image/webp", Native: true, Animated: true})
+	SourceFormatAVIF = sourceFormat.Define("AVIF", SourceFormatMeta{Extensions: []string{".avif"}, ContentType: "image/avif"})
+	SourceFormatGIF  = sourceFormat.Define("GIF", SourceFormatMeta{Extensions: []string{".gif"}, ContentType: "image/gif", Native: true, Animated: true})
+	SourceFormatTIFF = sourceFormat.Define("TIFF", SourceFormatMeta{Extensions: []string{".tif", ".tiff"}, ContentType: "image/tiff", Native: true})
+	SourceFormatBMP  = sourceFormat.Define("BMP", SourceFormatMeta{Extensions: []string{".bmp"}, ContentType: "image/bmp", Native: true})
+	SourceFormatJXL  = sourceFormat.Define("JXL", SourceFormatMeta{Extensions: []string{".jxl"}, ContentType: "image/jxl"})
+	SourceFormatHEIC = sourceFormat.Define("HEIC", SourceFormatMeta{Extensions: []string{".heic", ".heif"}, ContentType: "image/heic"})
+	SourceFormatMP4  = sourceFormat.Define("MP4", SourceFormatMeta{Extensions: []string{".mp4", ".m4v"}, ContentType: "video/mp4", Video: true})
+	SourceFormatWebM = sourceFormat.Define("WEBM", SourceFormatMeta{Extensions: []string{".webm"}, ContentType: "video/webm", Video: true})
+)
+
+// SourceFormat 源图片格式
+type SourceFormat = enum.Enum[SourceFormatMeta]
+
+type AnimatedPreviewMeta struct{}
+
+var animatedPreview = enum.New[AnimatedPreviewMeta]()
+var (
+	// AnimatedPreviewFirstFrame 动画图片的预览只使用第一帧
+	AnimatedPreviewFirstFrame = animatedPreview.Define("FIRST_FRAME")
+	// AnimatedPreviewAnimated 输出格式支持动画时保留动画，否则使用第一帧
+	AnimatedPreviewAnimated = animatedPreview.Define("ANIMATED")
+)
+
+// AnimatedPreview 动画图片的预览策略
+type AnimatedPreview = enum.Enum[AnimatedPreviewMeta]
+
+type MediaTypeMeta struct{}
+
+var mediaType = enum.New[MediaTypeMeta]()
+var (
+	MediaTypeImage = mediaType.Define("IMAGE")
+	MediaTypeVideo = mediaType.Define("VIDEO")
+)
+
+// MediaType 媒体类型，视频也作为图片参与会话和评分
+type MediaType = enum.Enum[MediaTypeMeta]
+
+type SessionLoadStateMeta struct{}
+
+var sessionLoadState = enum.New[SessionLoadStateMeta]()
+var (
+	// SessionLoadStateLoading 正在扫描目录，扫描到的图片陆续加入队列
+	SessionLoadStateLoading = sessionLoadState.Define("LOADING")
+	// SessionLoadStateReady 扫描完成
+	SessionLoadStateReady = sessionLoadState.Define("READY")
+	// SessionLoadStateCancelled 扫描被取消，会话只包含取消前扫描到的图片
+	SessionLoadStateCancelled = sessionLoadState.Define("CANCELLED")
+	// SessionLoadStateFailed 扫描失败，会话只包含失败前扫描到的图片
+	SessionLoadStateFailed = sessionLoadState.Define("FAILED")
+)
+
+// SessionLoadState 会话的加载状态
+type SessionLoadState = enum.Enum[SessionLoadStateMeta]
@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// FormatRegistry 支持的源图片格式，决定扫描时识别哪些文件，以及尺寸读取和预览生成使用纯 Go、ImageMagick 还是 FFmpeg
+type FormatRegistry struct {
+	formats         []SourceFormat
+	byExtension     map[string]SourceFormat
+	animatedPreview AnimatedPreview
+}
+
+// DefaultSourceFormats 默认支持的源图片格式
+func DefaultSourceFormats() []SourceFormat {
+	return []SourceFormat{
+		SourceFormatJPEG,
+		SourceFormatPNG,
+		SourceFormatWebP,
+		SourceFormatAVIF,
+		SourceFormatGIF,
+		SourceFormatTIFF,
+		SourceFormatBMP,
+		SourceFormatJXL,
+		SourceFormatHEIC,
+		SourceFormatMP4,
+		SourceFormatWebM,
+	}
+}
+
+// NewFormatRegistry 创建格式注册表，animatedPreview 为零值时使用第一帧
+func NewFormatRegistry(formats []SourceFormat, animatedPreview AnimatedPreview) *FormatRegistry {
+	if animatedPreview.IsZero() {
+		animatedPreview = AnimatedPreviewFirstFrame
+	}
+	r := &FormatRegistry{
+		byExtension:     make(map[string]SourceFormat),
+		animatedPreview: animatedPreview,
+	}
+	for _, format := range formats {
+		if slices.Contains(r.formats, format) {
+			continue
+		}
+		r.formats = append(r.formats, format)
+		for _, ext := range format.Meta().Extensions {
+			r.byExtension[ext] = format
+		}
+	}
+	return r
+}
+
+// NewDefaultFormatRegistry 使用默认格式创建格式注册表
+func NewDefaultFormatRegistry() *FormatRegistry {
+	return NewFormatRegistry(DefaultSourceFormats(), AnimatedPreviewFirstFrame)
+}
+
+// Formats 返回支持的格式
+func (r *FormatRegistry) Formats() []SourceFormat {
+	return slices.Clone(r.formats)
+}
+
+// Lookup 根据扩展名查找文件的格式
+func (r *FormatRegistry) Lookup(filename string) (SourceFormat, bool) {
+	format, ok := r.byExtension[strings.ToLower(filepath.Ext(filename))]
+	return format, ok
+}
+
+// IsSupported 判断文件是否为支持的图片
+func (r *FormatRegistry) IsSupported(filename string) bool {
+	_, ok := r.Lookup(filename)
+	return ok
+}
+
+// IsNative 判断文件是否可以使用纯 Go 解码
+func (r *FormatRegistry) IsNative(filename string) bool {
+	format, ok := r.Lookup(filename)
+	return ok && format.Meta().Native
+}
+
+// IsVideo 判断文件是否为视频
+func (r *FormatRegistry) IsVideo(filename string) bool {
+	format, ok := r.Lookup(filename)
+	return ok && format.Meta().Video
+}
+
+// AnimatedPreview 返回动画图片的预览策略
+func (r *FormatRegistry) AnimatedPreview() AnimatedPreview {
+	return r.animatedPreview
+}
+
+// KeepAnimation 判断生成 output 格式的预览时是否保留动画
+func (r *FormatRegistry) KeepAnimation(filename string, output ImageFormat) bool {
+	if r.animatedPreview != AnimatedPreviewAnimated {
+		return false
+	}
+	format, ok := r.Lookup(filename)
+	if !ok || !format.Meta().Animated {
+		return false
+	}
+	// 只有 WebP 输出可靠地支持动画
+	return output == ImageFormatWebP
+}